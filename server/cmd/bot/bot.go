@@ -0,0 +1,340 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"server/pkg/packets"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+const botPacketTimeout time.Duration = 10 * time.Second   // Time we wait for an answer during login
+const botHeartbeatTimeout time.Duration = 5 * time.Second // Heartbeats not answered in this time count as lost
+
+var chatLines = []string{
+	"anyone around?",
+	"watch out, hounds to the south",
+	"need ammo",
+	"gg",
+	"regroup at the wall",
+	"this is a load test, please ignore",
+}
+
+// A headless player that logs in and does random things until the context is cancelled
+type Bot struct {
+	index      int
+	username   string
+	nickname   string
+	config     *Config
+	report     *Report
+	connection *websocket.Conn
+	incoming   chan *packets.Packet // Packets read from the server
+	readErrors chan error
+
+	// Game state we need to act
+	clientId       uint64 // Sent by the server in the handshake
+	gridWidth      uint64
+	gridHeight     uint64
+	heartbeatTimes []time.Time // When each unanswered heartbeat was sent, the server answers them in order
+}
+
+func NewBot(index int, config *Config, report *Report) *Bot {
+	return &Bot{
+		index:      index,
+		username:   fmt.Sprintf("%s%d", config.Prefix, index),
+		nickname:   fmt.Sprintf("%s%d", config.Prefix, index),
+		config:     config,
+		report:     report,
+		incoming:   make(chan *packets.Packet, 256),
+		readErrors: make(chan error, 1),
+	}
+}
+
+// Connects, logs in (registering the account the first time) and plays until the context is done
+func (bot *Bot) Run(ctx context.Context) {
+	startedAt := time.Now()
+
+	connection, _, err := websocket.DefaultDialer.DialContext(ctx, bot.config.Address, nil)
+	if err != nil {
+		bot.report.AddError("connect")
+		return
+	}
+	bot.connection = connection
+	defer connection.Close()
+
+	bot.report.AddConnected()
+	defer bot.report.AddDisconnected()
+
+	go bot.readPump()
+
+	if err := bot.login(ctx); err != nil {
+		bot.report.AddError(err.Error())
+		return
+	}
+	bot.report.AddLogin(time.Since(startedAt))
+
+	if err := bot.play(ctx); err != nil {
+		bot.report.AddError(err.Error())
+		return
+	}
+
+	// Leave politely so the server saves our character
+	bot.send(&packets.Packet_LogoutRequest{LogoutRequest: &packets.LogoutRequest{}})
+	connection.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// Reads every packet the server sends us and passes it to the bot
+func (bot *Bot) readPump() {
+	for {
+		_, data, err := bot.connection.ReadMessage()
+		if err != nil {
+			bot.readErrors <- err
+			close(bot.incoming)
+			return
+		}
+
+		// The server appends a newline to every message
+		data = bytes.TrimSuffix(data, []byte{'\n'})
+
+		packet := &packets.Packet{}
+		if err := proto.Unmarshal(data, packet); err != nil {
+			bot.report.AddError("unmarshal")
+			continue
+		}
+		bot.report.AddReceived(fmt.Sprintf("%T", packet.Payload))
+
+		select {
+		case bot.incoming <- packet:
+		default:
+			// The bot is too slow to keep up, this is a problem on our side, not the server's
+			bot.report.AddError("bot incoming channel full")
+		}
+	}
+}
+
+// Serializes a packet and writes it to the websocket, the server fills the sender ID for us
+func (bot *Bot) send(payload packets.Payload) error {
+	data, err := proto.Marshal(&packets.Packet{Payload: payload})
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+
+	bot.connection.SetWriteDeadline(time.Now().Add(botPacketTimeout))
+	if err := bot.connection.WriteMessage(websocket.BinaryMessage, data); err != nil {
+		return errors.New("write")
+	}
+
+	_, isHeartbeat := payload.(*packets.Packet_Heartbeat)
+	bot.report.AddSent(isHeartbeat)
+	return nil
+}
+
+// Waits for the first packet of one of these types, ignoring the rest
+// Request denied packets are always returned so the caller can react to them
+func (bot *Bot) waitFor(ctx context.Context, payloadTypes ...string) (*packets.Packet, error) {
+	timeout := time.NewTimer(botPacketTimeout)
+	defer timeout.Stop()
+
+	for {
+		select {
+		case packet, ok := <-bot.incoming:
+			if !ok {
+				return nil, errors.New("disconnected during login")
+			}
+			payloadType := fmt.Sprintf("%T", packet.Payload)
+			if _, denied := packet.Payload.(*packets.Packet_RequestDenied); denied {
+				return packet, nil
+			}
+			for _, wanted := range payloadTypes {
+				if payloadType == wanted {
+					return packet, nil
+				}
+			}
+		case <-timeout.C:
+			return nil, fmt.Errorf("timeout waiting for %s", strings.Join(payloadTypes, " or "))
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Goes through the same steps as the Godot client: handshake, login (or register first) and enter the game
+func (bot *Bot) login(ctx context.Context) error {
+	packet, err := bot.waitFor(ctx, "*packets.Packet_Handshake")
+	if err != nil {
+		return err
+	}
+	bot.clientId = packet.GetSenderId()
+	// We answer with the server's own version so we never get stuck on a version mismatch
+	if err := bot.send(packets.NewHandshake(packet.GetHandshake().GetVersion())); err != nil {
+		return err
+	}
+
+	// The server metrics are sent once we are in the authentication state
+	if _, err := bot.waitFor(ctx, "*packets.Packet_ServerMetrics"); err != nil {
+		return err
+	}
+
+	loginRequest := &packets.Packet_LoginRequest{LoginRequest: &packets.LoginRequest{Username: bot.username, Password: bot.config.Password}}
+	if err := bot.send(loginRequest); err != nil {
+		return err
+	}
+	packet, err = bot.waitFor(ctx, "*packets.Packet_LoginSuccess")
+	if err != nil {
+		return err
+	}
+
+	// The first run creates the accounts
+	if packet.GetRequestDenied() != nil {
+		if !strings.Contains(packet.GetRequestDenied().GetReason(), "Invalid username or password") {
+			return errors.New("login denied: " + packet.GetRequestDenied().GetReason())
+		}
+
+		registerRequest := &packets.Packet_RegisterRequest{RegisterRequest: &packets.RegisterRequest{
+			Username: bot.username,
+			Nickname: bot.nickname,
+			Password: bot.config.Password,
+			Gender:   []string{"male", "female"}[bot.index%2],
+		}}
+		if err := bot.send(registerRequest); err != nil {
+			return err
+		}
+		packet, err = bot.waitFor(ctx, "*packets.Packet_RequestGranted")
+		if err != nil {
+			return err
+		}
+		if packet.GetRequestDenied() != nil {
+			return errors.New("register denied: " + packet.GetRequestDenied().GetReason())
+		}
+
+		if err := bot.send(loginRequest); err != nil {
+			return err
+		}
+		packet, err = bot.waitFor(ctx, "*packets.Packet_LoginSuccess")
+		if err != nil {
+			return err
+		}
+		if packet.GetRequestDenied() != nil {
+			return errors.New("login denied: " + packet.GetRequestDenied().GetReason())
+		}
+	}
+
+	// We need the size of the grid to pick destinations
+	packet, err = bot.waitFor(ctx, "*packets.Packet_RegionData")
+	if err != nil {
+		return err
+	}
+	bot.gridWidth = packet.GetRegionData().GetGridWidth()
+	bot.gridHeight = packet.GetRegionData().GetGridHeight()
+
+	if bot.config.Region != 0 && bot.config.Region != packet.GetRegionData().GetRegionId() {
+		if err := bot.send(&packets.Packet_JoinRegionRequest{JoinRegionRequest: &packets.JoinRegionRequest{RegionId: bot.config.Region}}); err != nil {
+			return err
+		}
+		packet, err = bot.waitFor(ctx, "*packets.Packet_RegionData")
+		if err != nil {
+			return err
+		}
+		bot.gridWidth = packet.GetRegionData().GetGridWidth()
+		bot.gridHeight = packet.GetRegionData().GetGridHeight()
+	}
+
+	return nil
+}
+
+// Sends heartbeats and random actions until the context is done or the server disconnects us
+func (bot *Bot) play(ctx context.Context) error {
+	heartbeatTicker := time.NewTicker(bot.config.HeartbeatInterval)
+	defer heartbeatTicker.Stop()
+
+	// Spread the bots so they don't all act on the same tick
+	actionTicker := time.NewTicker(bot.config.ActionInterval + time.Duration(rand.Int63n(int64(bot.config.ActionInterval)/10+1)))
+	defer actionTicker.Stop()
+
+	for {
+		select {
+		case packet, ok := <-bot.incoming:
+			if !ok {
+				err := <-bot.readErrors
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+					return nil
+				}
+				return errors.New("disconnected by the server")
+			}
+			bot.handlePacket(packet)
+
+		case <-heartbeatTicker.C:
+			bot.expireHeartbeats()
+			bot.heartbeatTimes = append(bot.heartbeatTimes, time.Now())
+			if err := bot.send(packets.NewHeartbeat()); err != nil {
+				return err
+			}
+
+		case <-actionTicker.C:
+			if err := bot.act(); err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (bot *Bot) handlePacket(packet *packets.Packet) {
+	switch payload := packet.Payload.(type) {
+	case *packets.Packet_Heartbeat:
+		// Heartbeats are answered in the order they were sent
+		if len(bot.heartbeatTimes) > 0 {
+			bot.report.AddLatency(time.Since(bot.heartbeatTimes[0]))
+			bot.heartbeatTimes = bot.heartbeatTimes[1:]
+		}
+	case *packets.Packet_RegionData:
+		bot.gridWidth = payload.RegionData.GetGridWidth()
+		bot.gridHeight = payload.RegionData.GetGridHeight()
+	case *packets.Packet_PlayerDied:
+		// Come back as soon as the region allows it
+		if payload.PlayerDied.GetTargetId() == bot.clientId {
+			bot.send(&packets.Packet_RespawnRequest{RespawnRequest: &packets.RespawnRequest{}})
+		}
+	}
+}
+
+// Counts the heartbeats that took too long as lost, if they show up later they are measured against the next one
+func (bot *Bot) expireHeartbeats() {
+	for len(bot.heartbeatTimes) > 0 && time.Since(bot.heartbeatTimes[0]) > botHeartbeatTimeout {
+		bot.report.AddHeartbeatLost()
+		bot.heartbeatTimes = bot.heartbeatTimes[1:]
+	}
+}
+
+// Does one random thing, weighted like a real player: mostly walking and shooting
+func (bot *Bot) act() error {
+	roll := rand.Float64()
+	switch {
+	case roll < 0.5:
+		return bot.send(&packets.Packet_Destination{Destination: &packets.Destination{
+			X: uint64(rand.Int63n(int64(max(bot.gridWidth, 1)))),
+			Z: uint64(rand.Int63n(int64(max(bot.gridHeight, 1)))),
+		}})
+	case roll < 0.75:
+		return bot.send(&packets.Packet_FireWeapon{FireWeapon: &packets.FireWeapon{Hit: &packets.Hit{
+			X: rand.Float32() * float32(bot.gridWidth),
+			Y: 1,
+			Z: rand.Float32() * float32(bot.gridHeight),
+		}}})
+	case roll < 0.9:
+		return bot.send(packets.NewSwitchWeapon(uint64(rand.Intn(2))))
+	default:
+		if err := bot.send(packets.NewChatBubble(true)); err != nil {
+			return err
+		}
+		return bot.send(packets.NewPublicMessage(bot.nickname, chatLines[rand.Intn(len(chatLines))]))
+	}
+}
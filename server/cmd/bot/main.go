@@ -0,0 +1,94 @@
+// Headless bots that log in and play like real clients, used for load and soak tests
+//
+//	go run ./cmd/bot -bots 200 -ramp 1m -duration 10m
+//
+// Accounts are named <prefix><number> and are registered the first time a bot can't log in
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	Address           string
+	Bots              int
+	RampUp            time.Duration // Time it takes to start every bot
+	Duration          time.Duration // Time every bot plays once all of them started
+	Prefix            string
+	Password          string
+	Region            uint64 // Region the bots move to after login (0 to stay where their character is)
+	ActionInterval    time.Duration
+	HeartbeatInterval time.Duration
+	ReportInterval    time.Duration
+}
+
+func main() {
+	config := &Config{}
+	flag.StringVar(&config.Address, "addr", "ws://localhost:31591/ws", "WebSocket address of the server")
+	flag.IntVar(&config.Bots, "bots", 10, "Number of bots")
+	flag.DurationVar(&config.RampUp, "ramp", 10*time.Second, "Time it takes to start every bot")
+	flag.DurationVar(&config.Duration, "duration", time.Minute, "Time the bots play once all of them started")
+	flag.StringVar(&config.Prefix, "prefix", "bot", "Prefix of the bot usernames and nicknames")
+	flag.StringVar(&config.Password, "password", "botpassword", "Password of every bot account")
+	flag.Uint64Var(&config.Region, "region", 0, "Region the bots join after login (0 to stay where they are)")
+	flag.DurationVar(&config.ActionInterval, "action", time.Second, "Time between random actions of each bot")
+	flag.DurationVar(&config.HeartbeatInterval, "heartbeat", time.Second, "Time between heartbeats of each bot, used to measure latency")
+	flag.DurationVar(&config.ReportInterval, "report", 5*time.Second, "Time between progress lines")
+	flag.Parse()
+
+	if config.Bots <= 0 || config.ActionInterval <= 0 || config.HeartbeatInterval <= 0 || config.ReportInterval <= 0 {
+		log.Fatal("bots and intervals must be greater than zero")
+	}
+
+	// Stop early with Ctrl+C and still print the report
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, config.RampUp+config.Duration)
+	defer cancel()
+
+	report := CreateReport()
+
+	// Print a progress line every few seconds so we can see where latency starts climbing
+	go func() {
+		ticker := time.NewTicker(config.ReportInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				report.WriteProgress(os.Stdout)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	log.Printf("Starting %d bots against %s over %v", config.Bots, config.Address, config.RampUp)
+
+	// Start the bots evenly spread over the ramp-up time
+	var waitGroup sync.WaitGroup
+	rampStep := config.RampUp / time.Duration(config.Bots)
+StartLoop:
+	for i := range config.Bots {
+		bot := NewBot(i+1, config, report)
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			bot.Run(ctx)
+		}()
+
+		select {
+		case <-time.After(rampStep):
+		case <-ctx.Done():
+			break StartLoop
+		}
+	}
+
+	waitGroup.Wait()
+	report.WriteSummary(os.Stdout)
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Everything the bots measured, shared by all of them
+type Report struct {
+	mutex sync.Mutex
+
+	startedAt time.Time
+
+	connected uint64 // Bots that opened a websocket
+	loggedIn  uint64 // Bots that reached the game state
+	online    uint64 // Bots still connected right now
+
+	packetsSent     uint64
+	packetsReceived uint64
+	packetsByType   map[string]uint64 // Received packets by payload type
+
+	heartbeatsSent uint64
+	heartbeatsLost uint64 // Heartbeats the server never answered (dropped or too late)
+
+	latencies      []time.Duration // Heartbeat round trips, they wait for the next hub tick so they show how busy it is
+	loginLatencies []time.Duration // From connecting to entering the game
+
+	errors map[string]uint64 // Error kind to how many times it happened
+}
+
+func CreateReport() *Report {
+	return &Report{
+		startedAt:     time.Now(),
+		packetsByType: make(map[string]uint64),
+		errors:        make(map[string]uint64),
+	}
+}
+
+func (report *Report) AddError(kind string) {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+	report.errors[kind]++
+}
+
+func (report *Report) AddConnected() {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+	report.connected++
+	report.online++
+}
+
+func (report *Report) AddDisconnected() {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+	report.online--
+}
+
+func (report *Report) AddLogin(latency time.Duration) {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+	report.loggedIn++
+	report.loginLatencies = append(report.loginLatencies, latency)
+}
+
+func (report *Report) AddSent(isHeartbeat bool) {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+	report.packetsSent++
+	if isHeartbeat {
+		report.heartbeatsSent++
+	}
+}
+
+func (report *Report) AddReceived(packetType string) {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+	report.packetsReceived++
+	report.packetsByType[packetType]++
+}
+
+func (report *Report) AddLatency(latency time.Duration) {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+	report.latencies = append(report.latencies, latency)
+}
+
+func (report *Report) AddHeartbeatLost() {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+	report.heartbeatsLost++
+}
+
+// One line summary, printed while the bots are running
+func (report *Report) WriteProgress(writer io.Writer) {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+
+	p50, p99 := percentile(report.latencies, 50), percentile(report.latencies, 99)
+	fmt.Fprintf(writer, "[%6s] online %d/%d, in game %d, sent %d, received %d, lost heartbeats %d, latency p50 %v p99 %v, errors %d\n",
+		time.Since(report.startedAt).Round(time.Second), report.online, report.connected, report.loggedIn,
+		report.packetsSent, report.packetsReceived, report.heartbeatsLost, p50, p99, sumValues(report.errors))
+}
+
+// Full summary, printed once every bot stopped
+func (report *Report) WriteSummary(writer io.Writer) {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+
+	elapsed := time.Since(report.startedAt)
+	fmt.Fprintf(writer, "\n=== Bot report (%v) ===\n", elapsed.Round(time.Second))
+	fmt.Fprintf(writer, "Bots connected: %d, reached the game: %d\n", report.connected, report.loggedIn)
+	fmt.Fprintf(writer, "Packets sent: %d (%.1f/s), received: %d (%.1f/s)\n",
+		report.packetsSent, float64(report.packetsSent)/elapsed.Seconds(),
+		report.packetsReceived, float64(report.packetsReceived)/elapsed.Seconds())
+
+	lostPercent := 0.0
+	if report.heartbeatsSent > 0 {
+		lostPercent = float64(report.heartbeatsLost) * 100 / float64(report.heartbeatsSent)
+	}
+	fmt.Fprintf(writer, "Heartbeats sent: %d, lost: %d (%.2f%%)\n", report.heartbeatsSent, report.heartbeatsLost, lostPercent)
+
+	writeLatencies(writer, "Heartbeat round trip", report.latencies)
+	writeLatencies(writer, "Login", report.loginLatencies)
+
+	fmt.Fprintln(writer, "Received packets by type:")
+	for _, name := range sortedKeys(report.packetsByType) {
+		fmt.Fprintf(writer, "  %-40s %d\n", name, report.packetsByType[name])
+	}
+
+	if len(report.errors) == 0 {
+		fmt.Fprintln(writer, "No errors")
+		return
+	}
+	fmt.Fprintln(writer, "Errors:")
+	for _, kind := range sortedKeys(report.errors) {
+		fmt.Fprintf(writer, "  %-40s %d\n", kind, report.errors[kind])
+	}
+}
+
+func writeLatencies(writer io.Writer, name string, latencies []time.Duration) {
+	if len(latencies) == 0 {
+		fmt.Fprintf(writer, "%s: no samples\n", name)
+		return
+	}
+	fmt.Fprintf(writer, "%s (%d samples): p50 %v, p90 %v, p95 %v, p99 %v, max %v\n", name, len(latencies),
+		percentile(latencies, 50), percentile(latencies, 90), percentile(latencies, 95),
+		percentile(latencies, 99), percentile(latencies, 100))
+}
+
+// Nearest-rank percentile, 0 if there are no samples
+func percentile(latencies []time.Duration, percent float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+
+	index := int(percent/100*float64(len(sorted))+0.5) - 1
+	index = max(min(index, len(sorted)-1), 0)
+	return sorted[index].Round(time.Microsecond)
+}
+
+func sumValues(values map[string]uint64) uint64 {
+	var sum uint64 = 0
+	for _, value := range values {
+		sum += value
+	}
+	return sum
+}
+
+func sortedKeys(values map[string]uint64) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,118 @@
+// Replays session recordings (made with the server's -record flag) through a fresh hub
+//
+//	go run ./cmd/replay recordings/20250101_120000.000_client3.rec recordings/20250101_120002.000_client4.rec
+//
+// Every recording gets its own fake client, the packets the player sent are fed back at the
+// same pace they were recorded and we compare what the server sends back against the recording
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"server/internal/server"
+	serverconfig "server/internal/server/config"
+	"server/internal/server/db"
+	"strings"
+	"sync"
+	"time"
+
+	_ "modernc.org/sqlite" // registers itself with the sql package
+)
+
+type Config struct {
+	Speed    float64       // 1 replays in real time, 2 twice as fast, 0 as fast as the hub takes them
+	Linger   time.Duration // Time we keep listening after the last packet before disconnecting
+	Password string        // Recordings have no passwords, every replayed account uses this one
+	Ignore   map[string]bool
+	Verbose  bool
+}
+
+func main() {
+	config := &Config{}
+	var ignore string
+	flag.Float64Var(&config.Speed, "speed", 1, "Replay speed (1 real time, 0 as fast as possible)")
+	flag.DurationVar(&config.Linger, "linger", 2*time.Second, "Time to keep listening after the last recorded packet")
+	flag.StringVar(&config.Password, "password", "replay-password", "Password used for every replayed account")
+	flag.StringVar(&ignore, "ignore", "MoveNpc,NpcFire", "Comma separated packet types left out of the comparison (NPCs are timing dependent)")
+	flag.BoolVar(&config.Verbose, "v", false, "Print every packet the server sends during the replay")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		log.Fatal("usage: replay [flags] <recording.rec>...")
+	}
+	if config.Speed < 0 {
+		log.Fatal("speed can't be negative")
+	}
+
+	config.Ignore = make(map[string]bool)
+	for _, packetType := range strings.Split(ignore, ",") {
+		if packetType = strings.TrimSpace(packetType); packetType != "" {
+			config.Ignore[packetType] = true
+		}
+	}
+
+	// Load every recording before starting so a bad file doesn't leave us halfway
+	sessions := []*Session{}
+	for _, path := range flag.Args() {
+		session, err := LoadSession(path)
+		if err != nil {
+			log.Fatalf("%s: %v", path, err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	// Everything is replayed relative to the first recorded packet
+	recordingStart := sessions[0].Start()
+	for _, session := range sessions[1:] {
+		if session.Start() < recordingStart {
+			recordingStart = session.Start()
+		}
+	}
+
+	// Fresh in-memory database, nothing from the live server leaks into the replay
+	database, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		log.Fatalf("opening database: %v", err)
+	}
+	// Every connection to :memory: is its own database, keep the one that gets the schema
+	database.SetMaxOpenConns(1)
+	defer database.Close()
+	_, err = database.ExecContext(context.Background(), db.Schema)
+	if err != nil {
+		log.Fatalf("creating schema: %v", err)
+	}
+
+	hub := server.CreateHub(database, serverconfig.Default())
+	go hub.Start()
+
+	// Sessions that logged into existing accounts need those accounts to exist here too
+	err = CreateAccounts(hub, sessions, config.Password)
+	if err != nil {
+		log.Fatalf("creating accounts: %v", err)
+	}
+
+	// Ctrl+C stops feeding packets but still prints what we got so far
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	log.Printf("Replaying %d sessions at speed %v", len(sessions), config.Speed)
+	replayStart := time.Now()
+
+	var waitGroup sync.WaitGroup
+	for _, session := range sessions {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			session.Replay(ctx, hub, config, recordingStart, replayStart)
+		}()
+	}
+	waitGroup.Wait()
+
+	for _, session := range sessions {
+		session.WriteReport(os.Stdout, config)
+	}
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"server/internal/server"
+	"server/internal/server/clients"
+	"server/pkg/packets"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/proto"
+)
+
+// When replaying as fast as possible we still space packets out, the hub only takes
+// a few packets per client each tick and drops them once the processing channel is full
+const fastReplayGap time.Duration = 10 * time.Millisecond
+
+// A packet the server sent to the fake client during the replay
+type ReplayedPacket struct {
+	Offset time.Duration // Time since the replay started
+	Packet *packets.Packet
+}
+
+// One recording and what happened when we replayed it
+type Session struct {
+	Path     string
+	Records  []*packets.RecordedPacket
+	Sent     int // Inbound packets fed back to the server
+	Replayed []*ReplayedPacket
+}
+
+// Reads a recording, it must have at least one packet
+func LoadSession(path string) (*Session, error) {
+	records, err := server.ReadRecording(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("recording is empty")
+	}
+	return &Session{Path: path, Records: records}, nil
+}
+
+// Timestamp of the first recorded packet
+func (session *Session) Start() int64 {
+	return session.Records[0].Timestamp
+}
+
+// ID the client had when it was recorded
+func (session *Session) RecordedClientId() uint64 {
+	return session.Records[0].ClientId
+}
+
+// Nickname the server gave this client when it logged in, empty if it never did
+func (session *Session) Nickname() string {
+	for _, record := range session.Records {
+		if success := record.Packet.GetLoginSuccess(); record.Outbound && success != nil {
+			return success.Nickname
+		}
+	}
+	return ""
+}
+
+// Gender of the recorded character, taken from its own spawn packet
+func (session *Session) Gender() string {
+	for _, record := range session.Records {
+		if spawn := record.Packet.GetSpawnCharacter(); record.Outbound && spawn != nil && spawn.Id == record.ClientId {
+			return spawn.Gender
+		}
+	}
+	return "male"
+}
+
+// Feeds the recorded inbound packets to a new fake client at the recorded pace and collects the answers
+func (session *Session) Replay(ctx context.Context, hub *server.Hub, config *Config, recordingStart int64, replayStart time.Time) {
+	// Waits until the moment this record happened in the recording, false if we were interrupted
+	waitFor := func(record *packets.RecordedPacket) bool {
+		var delay time.Duration
+		if config.Speed > 0 {
+			offset := time.Duration(float64(record.Timestamp-recordingStart) / config.Speed)
+			delay = time.Until(replayStart.Add(offset))
+		} else {
+			delay = fastReplayGap
+		}
+		select {
+		case <-time.After(delay):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	// The client connects when its first packet was recorded (the server's handshake)
+	if !waitFor(session.Records[0]) {
+		return
+	}
+	client := clients.NewFakeClient(hub)
+	hub.Connect(client)
+
+	// Collect everything the server sends until the client is gone
+	received := make(chan struct{})
+	go func() {
+		defer close(received)
+		for packet := range client.Received() {
+			replayed := &ReplayedPacket{Offset: time.Since(replayStart), Packet: packet}
+			session.Replayed = append(session.Replayed, replayed)
+			if config.Verbose {
+				log.Printf("%s [%v] %s: %v", session.Path, replayed.Offset.Round(time.Millisecond), packetType(packet), packet.Payload)
+			}
+		}
+	}()
+
+	for _, record := range session.Records {
+		if record.Outbound {
+			continue
+		}
+		if !waitFor(record) {
+			break
+		}
+
+		packet := proto.Clone(record.Packet).(*packets.Packet)
+		// Our fake client has a new ID, let the read pump fill it in
+		packet.SenderId = 0
+		// Passwords are not recorded
+		if login := packet.GetLoginRequest(); login != nil {
+			login.Password = config.Password
+		}
+		if register := packet.GetRegisterRequest(); register != nil {
+			register.Password = config.Password
+		}
+
+		client.SendRaw(packet)
+		session.Sent++
+	}
+
+	// Give the server time to answer the last packets
+	select {
+	case <-time.After(config.Linger):
+	case <-ctx.Done():
+	}
+
+	client.Disconnect()
+	<-client.Done()
+	<-received
+}
+
+// Prints how the replay compares to the recording
+func (session *Session) WriteReport(writer io.Writer, config *Config) {
+	recorded := []string{}
+	inbound := 0
+	for _, record := range session.Records {
+		if !record.Outbound {
+			inbound++
+			continue
+		}
+		if packetType := packetType(record.Packet); !config.Ignore[packetType] {
+			recorded = append(recorded, packetType)
+		}
+	}
+	replayed := []string{}
+	for _, packet := range session.Replayed {
+		if packetType := packetType(packet.Packet); !config.Ignore[packetType] {
+			replayed = append(replayed, packetType)
+		}
+	}
+
+	fmt.Fprintf(writer, "== %s (recorded client %d", session.Path, session.RecordedClientId())
+	if nickname := session.Nickname(); nickname != "" {
+		fmt.Fprintf(writer, ", %s", nickname)
+	}
+	fmt.Fprintln(writer, ")")
+	fmt.Fprintf(writer, "   inbound: %d of %d replayed\n", session.Sent, inbound)
+	fmt.Fprintf(writer, "   outbound: %d recorded, %d replayed\n", len(recorded), len(replayed))
+
+	// Find the first packet the server sent differently
+	divergence := -1
+	for i := range max(len(recorded), len(replayed)) {
+		if i >= len(recorded) || i >= len(replayed) || recorded[i] != replayed[i] {
+			divergence = i
+			break
+		}
+	}
+	if divergence == -1 {
+		fmt.Fprintln(writer, "   same outbound sequence")
+		return
+	}
+	fmt.Fprintf(writer, "   first divergence at outbound packet #%d: recorded %s, replayed %s\n",
+		divergence+1, valueAt(recorded, divergence), valueAt(replayed, divergence))
+
+	// Then how many packets of each type we are missing or got extra
+	counts := make(map[string][2]int)
+	for _, packetType := range recorded {
+		count := counts[packetType]
+		count[0]++
+		counts[packetType] = count
+	}
+	for _, packetType := range replayed {
+		count := counts[packetType]
+		count[1]++
+		counts[packetType] = count
+	}
+	types := []string{}
+	for packetType, count := range counts {
+		if count[0] != count[1] {
+			types = append(types, packetType)
+		}
+	}
+	slices.Sort(types)
+	for _, packetType := range types {
+		fmt.Fprintf(writer, "   %s: %d recorded, %d replayed\n", packetType, counts[packetType][0], counts[packetType][1])
+	}
+}
+
+// Accounts that sessions logged into (instead of registering) are created with the replay password
+func CreateAccounts(hub *server.Hub, sessions []*Session, password string) error {
+	// Accounts registered during the replay will be created by the server itself
+	registered := make(map[string]bool)
+	for _, session := range sessions {
+		for _, record := range session.Records {
+			if register := record.Packet.GetRegisterRequest(); !record.Outbound && register != nil {
+				registered[strings.ToLower(register.Username)] = true
+			}
+		}
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), hub.Config.BcryptCost)
+	if err != nil {
+		return fmt.Errorf("hashing password: %w", err)
+	}
+
+	for _, session := range sessions {
+		for _, record := range session.Records {
+			login := record.Packet.GetLoginRequest()
+			if record.Outbound || login == nil {
+				continue
+			}
+
+			username := strings.ToLower(login.Username)
+			if registered[username] {
+				continue
+			}
+			registered[username] = true
+
+			// The recording only tells us the nickname if the login worked
+			nickname := session.Nickname()
+			if nickname == "" {
+				nickname = username
+			}
+			_, err := hub.CreateUser(username, nickname, string(passwordHash), session.Gender())
+			if err != nil {
+				return fmt.Errorf("creating %s: %w", username, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Short name of the packet type, e.g. "SpawnCharacter"
+func packetType(packet *packets.Packet) string {
+	return packets.TypeName(packet.Payload)
+}
+
+func valueAt(values []string, index int) string {
+	if index < len(values) {
+		return values[index]
+	}
+	return "nothing"
+}
@@ -0,0 +1,567 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"server/internal/server/logging"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"strings"
+	"time"
+)
+
+const adminKickDelay time.Duration = 250 * time.Millisecond // Gives the write pump time to deliver the Kicked packet
+const adminMaxBodySize int64 = 64 * 1024                    // Bytes, every admin request is a small JSON object
+const adminMaxAnnouncementLength int = 256
+
+// Admin HTTP API, meant to be served on its own listener (see -admin-addr in main.go).
+// Every request needs the header "Authorization: Bearer <token>".
+// Anything that touches the game state runs on the hub goroutine through Do(), between ticks.
+func (h *Hub) AdminHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+
+	// Players
+	mux.HandleFunc("GET /players", h.adminListPlayers)
+	mux.HandleFunc("GET /players/{username}", h.adminGetPlayer)
+	mux.HandleFunc("POST /players/{username}/kick", h.adminKick)
+	mux.HandleFunc("POST /players/{username}/ban", h.adminRestrict(objects.RESTRICTION_BAN))
+	mux.HandleFunc("DELETE /players/{username}/ban", h.adminLiftRestriction(objects.RESTRICTION_BAN))
+	mux.HandleFunc("POST /players/{username}/mute", h.adminRestrict(objects.RESTRICTION_MUTE))
+	mux.HandleFunc("DELETE /players/{username}/mute", h.adminLiftRestriction(objects.RESTRICTION_MUTE))
+	mux.HandleFunc("POST /players/{username}/teleport", h.adminTeleport)
+	mux.HandleFunc("POST /players/{username}/save", h.adminSavePlayer)
+
+	// Server
+	mux.HandleFunc("GET /regions", h.adminListRegions)
+	mux.HandleFunc("POST /announcements", h.adminAnnounce)
+	mux.HandleFunc("POST /save", h.adminSaveAll)
+	mux.HandleFunc("POST /reload", h.adminReload)
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		// Constant time so the token can't be guessed one character at a time
+		given, found := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+		if !found || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			h.adminLogger.Warn("Rejected request", "method", request.Method, "path", request.URL.Path, "remote_addr", request.RemoteAddr)
+			writer.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(writer, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(writer, request)
+	})
+}
+
+type adminPlayerJSON struct {
+	ClientId  uint64 `json:"client_id"`
+	Username  string `json:"username"`
+	Nickname  string `json:"nickname"`
+	RegionId  uint64 `json:"region_id"`
+	X         uint64 `json:"x"`
+	Z         uint64 `json:"z"`
+	Health    uint64 `json:"health"`
+	MaxHealth uint64 `json:"max_health"`
+	Alive     bool   `json:"alive"`
+	GuildTag  string `json:"guild_tag"`
+	Muted     bool   `json:"muted"`
+}
+
+type adminWeaponJSON struct {
+	Slot        uint64 `json:"slot"`
+	WeaponName  string `json:"weapon_name"`
+	Ammo        uint64 `json:"ammo"`
+	ReserveAmmo uint64 `json:"reserve_ammo"`
+}
+
+type adminItemJSON struct {
+	Slot     uint64 `json:"slot"`
+	ItemName string `json:"item_name"`
+	Quantity uint64 `json:"quantity"`
+}
+
+type adminPlayerDetailJSON struct {
+	adminPlayerJSON
+	CharacterId    int64             `json:"character_id"`
+	MapId          uint64            `json:"map_id"`
+	RotationY      float64           `json:"rotation_y"`
+	Crouching      bool              `json:"crouching"`
+	SpawnProtected bool              `json:"spawn_protected"`
+	CurrentWeapon  uint64            `json:"current_weapon"`
+	Weapons        []adminWeaponJSON `json:"weapons"`
+	Inventory      []adminItemJSON   `json:"inventory"`
+	StatusEffects  []string          `json:"status_effects"`
+	MuteReason     string            `json:"mute_reason,omitempty"`
+	MutedUntil     int64             `json:"muted_until,omitempty"` // Unix time, 0 if permanent
+}
+
+type adminRegionJSON struct {
+	RegionId   uint64 `json:"region_id"`
+	Name       string `json:"name"`
+	Players    int    `json:"players"`
+	GridWidth  uint64 `json:"grid_width"`
+	GridHeight uint64 `json:"grid_height"`
+	MatchMode  string `json:"match_mode,omitempty"`
+	MatchPhase string `json:"match_phase,omitempty"`
+}
+
+// GET /players
+func (h *Hub) adminListPlayers(writer http.ResponseWriter, request *http.Request) {
+	players := []adminPlayerJSON{}
+	err := h.Do(func() {
+		h.Clients.ForEach(func(id uint64, client Client) {
+			if client.GetPlayerCharacter() != nil {
+				players = append(players, getAdminPlayer(client))
+			}
+		})
+	})
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	slices.SortFunc(players, func(a, b adminPlayerJSON) int {
+		return strings.Compare(a.Username, b.Username)
+	})
+	writeAdminJSON(writer, http.StatusOK, players)
+}
+
+// GET /players/{username}
+func (h *Hub) adminGetPlayer(writer http.ResponseWriter, request *http.Request) {
+	var detail *adminPlayerDetailJSON
+	h.doWithPlayer(writer, request, func(client Client, player *objects.Player) error {
+		now := time.Now()
+		detail = &adminPlayerDetailJSON{
+			adminPlayerJSON: getAdminPlayer(client),
+			CharacterId:     client.GetCharacterId(),
+			MapId:           player.GetMapId(),
+			RotationY:       player.RotationY,
+			Crouching:       player.IsCrouching(),
+			SpawnProtected:  player.IsSpawnProtected(now),
+			CurrentWeapon:   player.GetCurrentWeapon(),
+			Weapons:         []adminWeaponJSON{},
+			Inventory:       []adminItemJSON{},
+			StatusEffects:   []string{},
+		}
+		for slot, weapon := range *player.GetWeapons() {
+			if weapon != nil && weapon.WeaponName != "" {
+				detail.Weapons = append(detail.Weapons, adminWeaponJSON{
+					Slot:        uint64(slot),
+					WeaponName:  weapon.WeaponName,
+					Ammo:        weapon.Ammo,
+					ReserveAmmo: weapon.ReserveAmmo,
+				})
+			}
+		}
+		if inventory := player.GetInventory(); inventory != nil {
+			for slot, item := range inventory.GetSlots() {
+				if item != nil {
+					detail.Inventory = append(detail.Inventory, adminItemJSON{Slot: uint64(slot), ItemName: item.ItemName, Quantity: item.Quantity})
+				}
+			}
+		}
+		for name := range player.GetStatusEffects() {
+			detail.StatusEffects = append(detail.StatusEffects, name)
+		}
+		slices.Sort(detail.StatusEffects)
+		if mute := player.GetMute(); mute.IsActive(now) {
+			detail.MuteReason = mute.Reason
+			if !mute.IsPermanent() {
+				detail.MutedUntil = mute.ExpiresAt.Unix()
+			}
+		}
+		return nil
+	})
+	if detail != nil {
+		writeAdminJSON(writer, http.StatusOK, detail)
+	}
+}
+
+type adminReasonRequest struct {
+	Reason string `json:"reason"`
+}
+
+// POST /players/{username}/kick {"reason": "..."}
+func (h *Hub) adminKick(writer http.ResponseWriter, request *http.Request) {
+	body := &adminReasonRequest{}
+	if !readAdminRequest(writer, request, body) {
+		return
+	}
+
+	kicked := h.doWithPlayer(writer, request, func(client Client, player *objects.Player) error {
+		h.kick(client, body.Reason)
+		return nil
+	})
+	if kicked {
+		writeAdminJSON(writer, http.StatusOK, map[string]string{"kicked": request.PathValue("username")})
+	}
+}
+
+type adminRestrictRequest struct {
+	Reason  string `json:"reason"`
+	Minutes uint64 `json:"minutes"` // 0 never expires
+}
+
+// POST /players/{username}/ban and /players/{username}/mute {"reason": "...", "minutes": 60}
+// Works for offline accounts too, online players are kicked (ban) or muted right away
+func (h *Hub) adminRestrict(kind string) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		body := &adminRestrictRequest{}
+		if !readAdminRequest(writer, request, body) {
+			return
+		}
+
+		username := strings.ToLower(request.PathValue("username"))
+		duration := time.Duration(body.Minutes) * time.Minute
+
+		var restriction *objects.Restriction
+		var restrictErr error
+		err := h.Do(func() {
+			// Only the hub writes to the database
+			restriction, restrictErr = h.Restrict(username, kind, body.Reason, duration)
+			if restrictErr != nil {
+				return
+			}
+
+			client, online := h.GetClientByUsername(username)
+			if !online || client.GetPlayerCharacter() == nil {
+				return
+			}
+			switch kind {
+			case objects.RESTRICTION_BAN:
+				h.kick(client, restriction.Describe(time.Now()))
+			case objects.RESTRICTION_MUTE:
+				client.GetPlayerCharacter().SetMute(restriction)
+				client.SendPacket(packets.NewAnnouncement(restriction.Describe(time.Now())))
+			}
+		})
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		if restrictErr != nil {
+			http.Error(writer, restrictErr.Error(), http.StatusNotFound)
+			return
+		}
+
+		if restriction.IsPermanent() {
+			h.adminLogger.Info("Restricted account permanently", "kind", kind, logging.Username, username, "reason", body.Reason)
+		} else {
+			h.adminLogger.Info("Restricted account", "kind", kind, logging.Username, username, "duration", duration, "reason", body.Reason)
+		}
+
+		response := map[string]any{"username": username, "kind": kind, "reason": restriction.Reason, "expires_at": int64(0)}
+		if !restriction.IsPermanent() {
+			response["expires_at"] = restriction.ExpiresAt.Unix()
+		}
+		writeAdminJSON(writer, http.StatusOK, response)
+	}
+}
+
+// DELETE /players/{username}/ban and /players/{username}/mute
+func (h *Hub) adminLiftRestriction(kind string) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		username := strings.ToLower(request.PathValue("username"))
+
+		var liftErr error
+		err := h.Do(func() {
+			liftErr = h.LiftRestriction(username, kind)
+			if liftErr != nil {
+				return
+			}
+			if client, online := h.GetClientByUsername(username); online && kind == objects.RESTRICTION_MUTE {
+				if player := client.GetPlayerCharacter(); player != nil {
+					player.SetMute(nil)
+				}
+			}
+		})
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		if liftErr != nil {
+			http.Error(writer, liftErr.Error(), http.StatusNotFound)
+			return
+		}
+
+		h.adminLogger.Info("Lifted restriction", "kind", kind, logging.Username, username)
+		writeAdminJSON(writer, http.StatusOK, map[string]string{"username": username, "lifted": kind})
+	}
+}
+
+type adminTeleportRequest struct {
+	RegionId uint64 `json:"region_id"` // 0 stays in the current region
+	X        uint64 `json:"x"`
+	Z        uint64 `json:"z"`
+}
+
+// POST /players/{username}/teleport {"region_id": 2, "x": 5, "z": 5}
+// The player ends up on the nearest free cell to the requested one
+func (h *Hub) adminTeleport(writer http.ResponseWriter, request *http.Request) {
+	body := &adminTeleportRequest{}
+	if !readAdminRequest(writer, request, body) {
+		return
+	}
+
+	var result adminPlayerJSON
+	teleported := h.doWithPlayer(writer, request, func(client Client, player *objects.Player) error {
+		if client.GetRegion() == nil {
+			return errors.New("player is not in a region")
+		}
+		if !player.IsAlive() {
+			return errors.New("player is dead")
+		}
+
+		regionId := body.RegionId
+		if regionId == 0 {
+			regionId = client.GetRegion().GetId()
+		}
+		region, exists := h.GetRegionById(regionId)
+		if !exists {
+			return fmt.Errorf("region %d not found", regionId)
+		}
+		grid := region.GetGrid()
+		if body.X >= grid.GetMaxWidth() || body.Z >= grid.GetMaxHeight() {
+			return fmt.Errorf("cell (%d, %d) is outside of region %d", body.X, body.Z, regionId)
+		}
+
+		// Changing regions goes through the same code as a player asking for it, so everyone gets spawned
+		if regionId != client.GetRegion().GetId() {
+			client.ProcessPacket(client.GetId(), &packets.Packet_JoinRegionRequest{
+				JoinRegionRequest: &packets.JoinRegionRequest{RegionId: regionId},
+			})
+			if client.GetRegion() == nil || client.GetRegion().GetId() != regionId {
+				return fmt.Errorf("player could not join region %d", regionId)
+			}
+		}
+
+		cell := grid.GetSpawnCell(body.X, body.Z)
+		if cell == nil {
+			return fmt.Errorf("no free cell near (%d, %d)", body.X, body.Z)
+		}
+		grid.SetObject(cell, player)
+		player.SetGridDestination(cell)
+
+		// Spawning again is how clients are told about a position change that isn't a walk
+		spawnPacket := packets.NewSpawnCharacter(client.GetId(), player)
+		client.SendPacket(spawnPacket)
+		client.Broadcast(spawnPacket)
+
+		h.adminLogger.Info("Teleported player", logging.ClientId, client.GetId(), logging.Username, client.GetAccountUsername(), logging.RegionId, regionId, "x", cell.X, "z", cell.Z)
+		result = getAdminPlayer(client)
+		return nil
+	})
+	if teleported {
+		writeAdminJSON(writer, http.StatusOK, result)
+	}
+}
+
+// POST /players/{username}/save
+func (h *Hub) adminSavePlayer(writer http.ResponseWriter, request *http.Request) {
+	saved := h.doWithPlayer(writer, request, func(client Client, player *objects.Player) error {
+		return h.SaveCharacter(client)
+	})
+	if saved {
+		writeAdminJSON(writer, http.StatusOK, map[string]string{"saved": request.PathValue("username")})
+	}
+}
+
+// GET /regions
+func (h *Hub) adminListRegions(writer http.ResponseWriter, request *http.Request) {
+	regions := []adminRegionJSON{}
+	err := h.Do(func() {
+		h.Regions.ForEach(func(id uint64, region *Region) {
+			grid := region.GetGrid()
+			info := adminRegionJSON{
+				RegionId:   id,
+				Name:       region.Name,
+				Players:    region.Clients.Len(),
+				GridWidth:  grid.GetMaxWidth(),
+				GridHeight: grid.GetMaxHeight(),
+			}
+			if region.Match != nil {
+				info.MatchMode = region.Match.Config.Mode
+				info.MatchPhase = region.Match.Phase
+			}
+			regions = append(regions, info)
+		})
+	})
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	slices.SortFunc(regions, func(a, b adminRegionJSON) int {
+		return int(a.RegionId) - int(b.RegionId)
+	})
+	writeAdminJSON(writer, http.StatusOK, regions)
+}
+
+type adminAnnouncementRequest struct {
+	Text string `json:"text"`
+}
+
+// POST /announcements {"text": "Server restarts in 5 minutes"}
+func (h *Hub) adminAnnounce(writer http.ResponseWriter, request *http.Request) {
+	body := &adminAnnouncementRequest{}
+	if !readAdminRequest(writer, request, body) {
+		return
+	}
+	body.Text = strings.TrimSpace(body.Text)
+	if body.Text == "" || len(body.Text) > adminMaxAnnouncementLength {
+		http.Error(writer, fmt.Sprintf("text must have between 1 and %d characters", adminMaxAnnouncementLength), http.StatusBadRequest)
+		return
+	}
+
+	recipients := 0
+	err := h.Do(func() {
+		h.Clients.ForEach(func(id uint64, client Client) {
+			// Only players in game can show it
+			if client.GetPlayerCharacter() != nil {
+				client.SendPacket(packets.NewAnnouncement(body.Text))
+				recipients++
+			}
+		})
+	})
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	h.adminLogger.Info("Sent announcement", "recipients", recipients, "text", body.Text)
+	writeAdminJSON(writer, http.StatusOK, map[string]int{"recipients": recipients})
+}
+
+// POST /save, saves every online character
+func (h *Hub) adminSaveAll(writer http.ResponseWriter, request *http.Request) {
+	saved := 0
+	failed := []string{}
+	err := h.Do(func() {
+		h.Clients.ForEach(func(id uint64, client Client) {
+			player := client.GetPlayerCharacter()
+			if player == nil {
+				return
+			}
+			if err := h.SaveCharacter(client); err != nil {
+				h.adminLogger.Error("Failed to save character", logging.ClientId, id, logging.Username, client.GetAccountUsername(), "error", err)
+				failed = append(failed, client.GetAccountUsername())
+				return
+			}
+			saved++
+		})
+	})
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	h.adminLogger.Info("Saved characters", "saved", saved, "failed", len(failed))
+	slices.Sort(failed)
+	writeAdminJSON(writer, http.StatusOK, map[string]any{"saved": saved, "failed": failed})
+}
+
+// POST /reload
+// Re-reads the game data files, on validation errors nothing changes and every problem is listed
+func (h *Hub) adminReload(writer http.ResponseWriter, request *http.Request) {
+	report, err := h.ReloadGameData()
+	var dataErr *GameDataError
+	if errors.As(err, &dataErr) {
+		h.adminLogger.Warn("Game data reload rejected", "problems", len(dataErr.Problems))
+		writeAdminJSON(writer, http.StatusUnprocessableEntity, map[string][]string{"errors": dataErr.Problems})
+		return
+	}
+	if errors.Is(err, ErrNoDataFolder) {
+		http.Error(writer, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	h.adminLogger.Info("Game data reloaded", "files", report.Files, "weapons", report.Weapons,
+		"respawner_regions", report.RespawnerRegions, "chat_filter_words", report.ChatFilterWords)
+	writeAdminJSON(writer, http.StatusOK, report)
+}
+
+// Tells the client why and closes the connection once the packet had time to go out
+func (h *Hub) kick(client Client, reason string) {
+	h.adminLogger.Info("Kicking player", logging.ClientId, client.GetId(), logging.Username, client.GetAccountUsername(), "reason", reason)
+	client.SendPacket(packets.NewKicked(reason))
+	time.AfterFunc(adminKickDelay, func() {
+		// Close only runs once and cleans up on the hub goroutine, the pumps calling it too is fine
+		client.Close("was kicked: " + reason)
+	})
+}
+
+// Runs the action on the hub goroutine with the online player from the {username} path value.
+// Writes the error response itself, returns true if the action succeeded.
+func (h *Hub) doWithPlayer(writer http.ResponseWriter, request *http.Request, action func(Client, *objects.Player) error) bool {
+	username := strings.ToLower(request.PathValue("username"))
+
+	found := false
+	var actionErr error
+	err := h.Do(func() {
+		client, online := h.GetClientByUsername(username)
+		if !online || client.GetPlayerCharacter() == nil {
+			return
+		}
+		found = true
+		actionErr = action(client, client.GetPlayerCharacter())
+	})
+
+	switch {
+	case err != nil:
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+	case !found:
+		http.Error(writer, fmt.Sprintf("%s is not online", username), http.StatusNotFound)
+	case actionErr != nil:
+		http.Error(writer, actionErr.Error(), http.StatusConflict)
+	default:
+		return true
+	}
+	return false
+}
+
+// Summary of an online player, call it from the hub goroutine
+func getAdminPlayer(client Client) adminPlayerJSON {
+	player := client.GetPlayerCharacter()
+	summary := adminPlayerJSON{
+		ClientId:  client.GetId(),
+		Username:  client.GetAccountUsername(),
+		Nickname:  player.Name,
+		RegionId:  player.GetRegionId(),
+		Health:    player.GetHealth(),
+		MaxHealth: player.GetMaxHealth(),
+		Alive:     player.IsAlive(),
+		GuildTag:  player.GetGuildTag(),
+		Muted:     player.GetMute().IsActive(time.Now()),
+	}
+	if position := player.GetGridPosition(); position != nil {
+		summary.X = position.X
+		summary.Z = position.Z
+	}
+	return summary
+}
+
+// Decodes the JSON body, an empty body leaves the defaults. Writes the error response itself.
+func readAdminRequest(writer http.ResponseWriter, request *http.Request, body any) bool {
+	request.Body = http.MaxBytesReader(writer, request.Body, adminMaxBodySize)
+	decoder := json.NewDecoder(request.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(body); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(writer, "invalid body: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func writeAdminJSON(writer http.ResponseWriter, status int, response any) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		logging.For(logging.Admin).Warn("Failed to send admin response", "error", err)
+	}
+}
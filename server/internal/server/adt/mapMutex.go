@@ -6,7 +6,7 @@ import "sync"
 type MapMutex[T any] struct {
 	objects map[uint64]T
 	nextId  uint64
-	mutex   sync.Mutex
+	mutex   sync.RWMutex // Readers (Get, Len, ForEach) don't block each other
 }
 
 // Constructor for the MapMutex that allows us to specify the initial capacity of the map
@@ -58,7 +58,7 @@ func (s *MapMutex[T]) Remove(id uint64) {
 // Creates a copy of the map, then executes the callback function for each object in the copy
 func (s *MapMutex[T]) ForEach(callback func(uint64, T)) {
 	// Lock the map so no other goroutine modify it while we are iterating over it
-	s.mutex.Lock()
+	s.mutex.RLock()
 	// Create a local copy of the map while holding the lock
 	localCopy := make(map[uint64]T, len(s.objects))
 	for id, obj := range s.objects {
@@ -68,7 +68,7 @@ func (s *MapMutex[T]) ForEach(callback func(uint64, T)) {
 	// If the callback function takes a long time to execute, we don't want to hold the lock
 	// for that long as it could block other goroutines from accessing the map,
 	// so we unlock the map after creating the copy, and call the function for the copy instead
-	s.mutex.Unlock()
+	s.mutex.RUnlock()
 
 	// Iterate over the local copy
 	for id, obj := range localCopy {
@@ -79,22 +79,25 @@ func (s *MapMutex[T]) ForEach(callback func(uint64, T)) {
 // Gets the object by ID, if it exists, otherwise nil
 // Also returns a boolean indicating whether the object was found
 func (s *MapMutex[T]) Get(id uint64) (T, bool) {
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
 
 	obj, found := s.objects[id]
 	return obj, found
 }
 
-// Gets the approximate number of objects in the map (it doesn't lock the map)
+// Gets the number of objects in the map, regions call this every tick while clients come and go
 func (s *MapMutex[T]) Len() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	return len(s.objects)
 }
 
 // Creates a copy of the map, then executes the callback function for each object in the copy
 func (s *MapMutex[T]) ForEachWithBreak(callback func(uint64, T) bool) {
 	// Lock the map so no other goroutine modify it while we are iterating over it
-	s.mutex.Lock()
+	s.mutex.RLock()
 	// Create a local copy of the map while holding the lock
 	localCopy := make(map[uint64]T, len(s.objects))
 	for id, obj := range s.objects {
@@ -104,7 +107,7 @@ func (s *MapMutex[T]) ForEachWithBreak(callback func(uint64, T) bool) {
 	// If the callback function takes a long time to execute, we don't want to hold the lock
 	// for that long as it could block other goroutines from accessing the map,
 	// so we unlock the map after creating the copy, and call the function for the copy instead
-	s.mutex.Unlock()
+	s.mutex.RUnlock()
 
 	// Iterate over the local copy
 	for id, obj := range localCopy {
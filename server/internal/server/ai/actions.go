@@ -0,0 +1,117 @@
+package ai
+
+// Facts sensed by NPCs every time they think
+const (
+	FACT_HAS_TARGET      string = "has_target"      // A player is within sight
+	FACT_TARGET_IN_RANGE string = "target_in_range" // The target is within attack range
+	FACT_TARGET_DEAD     string = "target_dead"     // Never sensed, it's what attacks achieve
+	FACT_AT_POST         string = "at_post"         // Standing on the cell we guard
+	FACT_IN_COVER        string = "in_cover"        // Standing on a cell that protects us from the target
+	FACT_LOW_HEALTH      string = "low_health"      // Below 40% health
+	FACT_UNDER_FIRE      string = "under_fire"      // Took damage very recently
+	FACT_DODGED          string = "dodged"          // Never sensed, it's what dodges achieve
+	FACT_AMBUSH_READY    string = "ambush_ready"    // Hidden and waiting for the target to come closer
+)
+
+// Goals from docs/ai.txt
+const (
+	GOAL_GUARD  string = "Guard"
+	GOAL_KILL   string = "Kill"
+	GOAL_DODGE  string = "Dodge"
+	GOAL_COVER  string = "Cover"
+	GOAL_AMBUSH string = "Ambush"
+)
+
+// Actions from docs/ai.txt, MoveTo is split by destination
+const (
+	ACTION_IDLE                           string = "Idle"
+	ACTION_DODGE                          string = "Dodge"
+	ACTION_BLOCK                          string = "Block"
+	ACTION_ATTACK_RANGED                  string = "AttackRanged"
+	ACTION_ATTACK_MELEE                   string = "AttackMelee"
+	ACTION_MOVE_TO_POST                   string = "MoveToPost"
+	ACTION_MOVE_TO_TARGET                 string = "MoveToTarget"
+	ACTION_MOVE_TO_COVER                  string = "MoveToCover"
+	ACTION_ATTACK_RANGED_FROM_COVER       string = "AttackRangedFromCover"
+	ACTION_DODGE_COVERED                  string = "DodgeCovered"
+	ACTION_BLIND_ATTACK_RANGED_FROM_COVER string = "BlindAttackRangedFromCover"
+)
+
+// Goal definitions
+var Goals = map[string]*Goal{
+	GOAL_GUARD:  {Name: GOAL_GUARD, Desired: WorldState{FACT_AT_POST: true}},
+	GOAL_KILL:   {Name: GOAL_KILL, Desired: WorldState{FACT_TARGET_DEAD: true}},
+	GOAL_DODGE:  {Name: GOAL_DODGE, Desired: WorldState{FACT_DODGED: true}},
+	GOAL_COVER:  {Name: GOAL_COVER, Desired: WorldState{FACT_IN_COVER: true}},
+	GOAL_AMBUSH: {Name: GOAL_AMBUSH, Desired: WorldState{FACT_AMBUSH_READY: true}},
+}
+
+// Action definitions, each NPC template picks the ones it can perform
+var Actions = map[string]*Action{
+	ACTION_IDLE: {Name: ACTION_IDLE, Cost: 1,
+		Preconditions: WorldState{FACT_IN_COVER: true},
+		Effects:       WorldState{FACT_AMBUSH_READY: true}},
+	ACTION_DODGE: {Name: ACTION_DODGE, Cost: 2,
+		Effects: WorldState{FACT_DODGED: true}},
+	ACTION_BLOCK: {Name: ACTION_BLOCK, Cost: 3,
+		Effects: WorldState{FACT_DODGED: true}},
+	ACTION_DODGE_COVERED: {Name: ACTION_DODGE_COVERED, Cost: 1,
+		Preconditions: WorldState{FACT_IN_COVER: true},
+		Effects:       WorldState{FACT_DODGED: true}},
+	ACTION_MOVE_TO_POST: {Name: ACTION_MOVE_TO_POST, Cost: 2,
+		Effects: WorldState{FACT_AT_POST: true}},
+	ACTION_MOVE_TO_TARGET: {Name: ACTION_MOVE_TO_TARGET, Cost: 3,
+		Preconditions: WorldState{FACT_HAS_TARGET: true},
+		Effects:       WorldState{FACT_TARGET_IN_RANGE: true, FACT_IN_COVER: false, FACT_AT_POST: false}},
+	ACTION_MOVE_TO_COVER: {Name: ACTION_MOVE_TO_COVER, Cost: 2,
+		Preconditions: WorldState{FACT_HAS_TARGET: true},
+		Effects:       WorldState{FACT_IN_COVER: true, FACT_AT_POST: false}},
+	ACTION_ATTACK_RANGED: {Name: ACTION_ATTACK_RANGED, Cost: 4,
+		Preconditions: WorldState{FACT_HAS_TARGET: true, FACT_TARGET_IN_RANGE: true},
+		Effects:       WorldState{FACT_TARGET_DEAD: true}},
+	ACTION_ATTACK_MELEE: {Name: ACTION_ATTACK_MELEE, Cost: 2,
+		Preconditions: WorldState{FACT_HAS_TARGET: true, FACT_TARGET_IN_RANGE: true},
+		Effects:       WorldState{FACT_TARGET_DEAD: true}},
+	ACTION_ATTACK_RANGED_FROM_COVER: {Name: ACTION_ATTACK_RANGED_FROM_COVER, Cost: 2,
+		Preconditions: WorldState{FACT_HAS_TARGET: true, FACT_TARGET_IN_RANGE: true, FACT_IN_COVER: true},
+		Effects:       WorldState{FACT_TARGET_DEAD: true}},
+	ACTION_BLIND_ATTACK_RANGED_FROM_COVER: {Name: ACTION_BLIND_ATTACK_RANGED_FROM_COVER, Cost: 5,
+		Preconditions: WorldState{FACT_HAS_TARGET: true, FACT_IN_COVER: true},
+		Effects:       WorldState{FACT_TARGET_DEAD: true}},
+}
+
+// Returns the actions with these names, unknown names are skipped
+func GetActions(names []string) []*Action {
+	actions := make([]*Action, 0, len(names))
+	for _, name := range names {
+		if action, exists := Actions[name]; exists {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// Returns how urgent a goal is in this state, 0 means it shouldn't be pursued right now
+func GetGoalPriority(goal string, state WorldState) int {
+	switch goal {
+	case GOAL_DODGE:
+		if state[FACT_UNDER_FIRE] {
+			return 8
+		}
+	case GOAL_COVER:
+		if state[FACT_HAS_TARGET] && state[FACT_LOW_HEALTH] {
+			return 7
+		}
+	case GOAL_AMBUSH:
+		if state[FACT_HAS_TARGET] && !state[FACT_TARGET_IN_RANGE] {
+			return 6
+		}
+	case GOAL_KILL:
+		if state[FACT_HAS_TARGET] {
+			return 5
+		}
+	case GOAL_GUARD:
+		return 1
+	}
+	return 0
+}
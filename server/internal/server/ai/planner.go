@@ -0,0 +1,150 @@
+package ai
+
+import (
+	"container/heap"
+	"sort"
+	"strings"
+)
+
+// Facts an agent knows about the world, like "has_target" or "in_cover"
+// Facts that are missing are false
+type WorldState map[string]bool
+
+// Returns true if every fact in the desired state has the same value in this state
+func (state WorldState) Satisfies(desired WorldState) bool {
+	for fact, value := range desired {
+		if state[fact] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns a copy of this state with the effects applied on top
+func (state WorldState) Apply(effects WorldState) WorldState {
+	next := make(WorldState, len(state)+len(effects))
+	for fact, value := range state {
+		next[fact] = value
+	}
+	for fact, value := range effects {
+		next[fact] = value
+	}
+	return next
+}
+
+// Unique key of the true facts, used to avoid exploring the same state twice
+func (state WorldState) key() string {
+	facts := make([]string, 0, len(state))
+	for fact, value := range state {
+		if value {
+			facts = append(facts, fact)
+		}
+	}
+	sort.Strings(facts)
+	return strings.Join(facts, ",")
+}
+
+// Something an agent can do to change the world
+type Action struct {
+	Name          string
+	Cost          int
+	Preconditions WorldState
+	Effects       WorldState
+}
+
+// State an agent wants the world to be in
+type Goal struct {
+	Name    string
+	Desired WorldState
+}
+
+// Max actions in a single plan, keeps the search cheap on the server tick
+const maxPlanLength int = 5
+
+// Node of the search, holds the state reached and how we got there
+type planNode struct {
+	state  WorldState
+	action *Action
+	parent *planNode
+	cost   int
+	depth  int
+	index  int
+}
+
+// Min heap of plan nodes ordered by cost
+type planQueue []*planNode
+
+func (pq planQueue) Len() int           { return len(pq) }
+func (pq planQueue) Less(i, j int) bool { return pq[i].cost < pq[j].cost }
+func (pq planQueue) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+	pq[i].index = i
+	pq[j].index = j
+}
+func (pq *planQueue) Push(x interface{}) {
+	node := x.(*planNode)
+	node.index = len(*pq)
+	*pq = append(*pq, node)
+}
+func (pq *planQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	node := old[n-1]
+	*pq = old[0 : n-1]
+	return node
+}
+
+// Finds the cheapest sequence of actions that takes the current state to the goal
+// Returns nil if the goal can't be reached, or an empty plan if it's already satisfied
+func Plan(current WorldState, goal *Goal, actions []*Action) []*Action {
+	if current.Satisfies(goal.Desired) {
+		return []*Action{}
+	}
+
+	openSet := &planQueue{}
+	heap.Init(openSet)
+	heap.Push(openSet, &planNode{state: current})
+	visited := map[string]int{current.key(): 0}
+
+	for openSet.Len() > 0 {
+		node := heap.Pop(openSet).(*planNode)
+
+		if node.state.Satisfies(goal.Desired) {
+			// Walk back to the start to build the plan in order
+			plan := make([]*Action, node.depth)
+			for step := node; step.parent != nil; step = step.parent {
+				plan[step.depth-1] = step.action
+			}
+			return plan
+		}
+
+		if node.depth >= maxPlanLength {
+			continue
+		}
+
+		for _, action := range actions {
+			if !node.state.Satisfies(action.Preconditions) {
+				continue
+			}
+			next := node.state.Apply(action.Effects)
+			cost := node.cost + action.Cost
+
+			// Skip states we already reached for less
+			key := next.key()
+			if previousCost, seen := visited[key]; seen && previousCost <= cost {
+				continue
+			}
+			visited[key] = cost
+
+			heap.Push(openSet, &planNode{
+				state:  next,
+				action: action,
+				parent: node,
+				cost:   cost,
+				depth:  node.depth + 1,
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"os"
+	"server/internal/server/logging"
+	"sync"
+	"time"
+)
+
+// Minimum time between two checks of the certificate files, handshakes in between reuse the last check
+const certificateCheckInterval time.Duration = 10 * time.Second
+
+// Serves the TLS certificate from a cert and key file and picks up new files (e.g. renewed by certbot)
+// without restarting. If the new files are broken we keep serving the old certificate.
+type CertificateReloader struct {
+	certFile    string
+	keyFile     string
+	certificate *tls.Certificate
+	modTime     time.Time // Newest modification time of both files when they were loaded
+	lastCheck   time.Time
+	mutex       sync.Mutex // Handshakes run on their own goroutines
+	logger      *slog.Logger
+}
+
+// Loads the certificate, fails if the files are missing or don't match
+func NewCertificateReloader(certFile string, keyFile string) (*CertificateReloader, error) {
+	reloader := &CertificateReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		logger:   logging.For(logging.Hub).With("cert_file", certFile),
+	}
+	modTime, err := reloader.getModTime()
+	if err != nil {
+		return nil, err
+	}
+	if err := reloader.load(modTime); err != nil {
+		return nil, err
+	}
+	reloader.lastCheck = time.Now()
+	return reloader, nil
+}
+
+// TLS config that asks us for the certificate on every handshake
+func (r *CertificateReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.GetCertificate,
+	}
+}
+
+// Used as tls.Config.GetCertificate
+func (r *CertificateReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if time.Since(r.lastCheck) >= certificateCheckInterval {
+		r.lastCheck = time.Now()
+		modTime, err := r.getModTime()
+		if err != nil {
+			r.logger.Error("Failed to check the certificate files, keeping the current certificate", "error", err)
+		} else if modTime.After(r.modTime) {
+			if err := r.load(modTime); err != nil {
+				r.logger.Error("Failed to reload the certificate, keeping the current one", "error", err)
+			} else {
+				r.logger.Info("Certificate reloaded")
+			}
+		}
+	}
+
+	return r.certificate, nil
+}
+
+// Must hold the mutex (or be the constructor)
+func (r *CertificateReloader) load(modTime time.Time) error {
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("loading certificate: %w", err)
+	}
+	r.certificate = &certificate
+	r.modTime = modTime
+	return nil
+}
+
+// Renewals replace both files, the newest of the two tells us if anything changed
+func (r *CertificateReloader) getModTime() (time.Time, error) {
+	var newest time.Time
+	for _, path := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return newest, err
+		}
+		if info.ModTime().After(newest) {
+			newest = info.ModTime()
+		}
+	}
+	return newest, nil
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Hides words and phrases from the public and guild chats, loaded from chat_filter.txt (see gamedata.go)
+// Only whole words are hidden, "ass" doesn't touch "class"
+type ChatFilter struct {
+	words   []string
+	pattern *regexp.Regexp // nil if there are no words
+}
+
+// Builds a filter from a list of words or phrases, matching ignores case
+func NewChatFilter(words []string) (*ChatFilter, error) {
+	filter := &ChatFilter{}
+	quoted := []string{}
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+		if !strings.ContainsFunc(word, isWordRune) {
+			return nil, fmt.Errorf("%q has no letters or digits", word)
+		}
+		filter.words = append(filter.words, word)
+		quoted = append(quoted, regexp.QuoteMeta(word))
+	}
+
+	// Longer phrases first, otherwise "bad" would win over "bad word"
+	slices.SortFunc(quoted, func(a, b string) int {
+		return len(b) - len(a)
+	})
+	if len(quoted) > 0 {
+		pattern, err := regexp.Compile("(?i)" + strings.Join(quoted, "|"))
+		if err != nil {
+			return nil, fmt.Errorf("building chat filter: %w", err)
+		}
+		filter.pattern = pattern
+	}
+	return filter, nil
+}
+
+// Words and phrases this filter hides
+func (f *ChatFilter) Len() int {
+	if f == nil {
+		return 0
+	}
+	return len(f.words)
+}
+
+// Replaces every filtered word with asterisks, safe to call on a nil filter
+func (f *ChatFilter) Censor(text string) string {
+	if f == nil || f.pattern == nil {
+		return text
+	}
+
+	var builder strings.Builder
+	last := 0
+	for _, match := range f.pattern.FindAllStringIndex(text, -1) {
+		start, end := match[0], match[1]
+		// Only whole words, the characters around the match can't be part of a word
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if start > 0 && isWordRune(before) || end < len(text) && isWordRune(after) {
+			continue
+		}
+
+		builder.WriteString(text[last:start])
+		builder.WriteString(strings.Repeat("*", utf8.RuneCountInString(text[start:end])))
+		last = end
+	}
+	if last == 0 {
+		return text
+	}
+	builder.WriteString(text[last:])
+	return builder.String()
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Filters a chat message with the current chat filter, only call this from the hub goroutine
+func (h *Hub) FilterChat(text string) string {
+	return h.chatFilter.Censor(text)
+}
@@ -0,0 +1,388 @@
+package clients
+
+import (
+	"log/slog"
+	"server/internal/server"
+	"server/internal/server/logging"
+	"server/internal/server/objects"
+	"server/internal/server/states"
+	"server/pkg/packets"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// In-process client used by tests and tools, it behaves like a WebSocketClient
+// but the "connection" is a pair of channels instead of a websocket:
+// - Whatever we push with Send() is what the Godot client would have written
+// - Whatever the server sends us can be read from Received()
+type FakeClient struct {
+	id                uint64               // Ephemeral ID for this connection
+	characterId       int64                // Character ID stored in the db (only for server use)
+	hub               *server.Hub          // Hub that this client connected to
+	region            *server.Region       // Region that this client is at
+	inbox             chan *packets.Packet // Packets "written" by the fake game client
+	outbox            chan *packets.Packet // Packets that made it through the write pump
+	sendChannel       chan *packets.Packet // Channel that holds packets to be sent to the client
+	processingChannel chan *packets.Packet // Channel that holds packets to be sent to the server
+	state             server.ClientState   // In what state the client is in
+	playerCharacter   *objects.Player      // The player's data is stored in his character
+	accountUsername   string               // Username of the account that is connected
+	disconnected      chan struct{}        // Closed when the fake game client hangs up
+	done              chan struct{}        // Closed once the client has been cleaned up
+	disconnectOnce    sync.Once            // We can only hang up once
+	closeOnce         sync.Once            // Both pumps try to close the client, only the first one counts
+	logger            *slog.Logger
+}
+
+// Creates a new fake client, pass it to Hub.Connect() to plug it into the server
+func NewFakeClient(hub *server.Hub) *FakeClient {
+	return &FakeClient{
+		hub:               hub,
+		region:            nil,                                                         // Before login, the client's region is nil
+		inbox:             make(chan *packets.Packet, hub.Config.ProcessingBufferSize), // Same size as the processing channel
+		outbox:            make(chan *packets.Packet, 2*hub.Config.SendBufferSize),     // Bigger than the send channel so slow tests don't lose packets
+		sendChannel:       make(chan *packets.Packet, hub.Config.SendBufferSize),       // Same as the WebSocketClient, drops packets if full
+		processingChannel: make(chan *packets.Packet, hub.Config.ProcessingBufferSize), // Same as the WebSocketClient, drops packets if full
+		disconnected:      make(chan struct{}),
+		done:              make(chan struct{}),
+		logger:            logging.For(logging.Clients),
+	}
+}
+
+// Queues a packet as if the game client had sent it, the sender ID is filled in by the read pump
+func (c *FakeClient) Send(payload packets.Payload) {
+	c.SendRaw(&packets.Packet{Payload: payload})
+}
+
+// Queues a full packet as if the game client had sent it, useful to spoof sender IDs
+func (c *FakeClient) SendRaw(packet *packets.Packet) {
+	select {
+	case c.inbox <- packet:
+	case <-c.disconnected:
+		// The client is gone, nobody is listening anymore
+	}
+}
+
+// Packets the server sent to this client, in order
+// The channel is closed after the client disconnects and every pending packet was delivered
+func (c *FakeClient) Received() <-chan *packets.Packet {
+	return c.outbox
+}
+
+// Closed once the server is done cleaning up this client
+func (c *FakeClient) Done() <-chan struct{} {
+	return c.done
+}
+
+// Simulates the game client closing the connection
+func (c *FakeClient) Disconnect() {
+	c.disconnectOnce.Do(func() {
+		close(c.disconnected)
+	})
+}
+
+// CharacterID get/set
+func (c *FakeClient) GetCharacterId() int64 {
+	return c.characterId
+}
+func (c *FakeClient) SetCharacterId(id int64) {
+	c.characterId = id
+}
+
+// Character get/set
+func (c *FakeClient) GetPlayerCharacter() *objects.Player {
+	return c.playerCharacter
+}
+func (c *FakeClient) SetPlayerCharacter(playerCharacter *objects.Player) {
+	c.playerCharacter = playerCharacter
+}
+
+// Account username get/set
+func (c *FakeClient) GetAccountUsername() string {
+	return c.accountUsername
+}
+func (c *FakeClient) SetAccountUsername(username string) {
+	c.accountUsername = username
+}
+
+// Returns the Hub this client is connected to
+func (c *FakeClient) GetHub() *server.Hub {
+	return c.hub
+}
+
+// Region get/set
+func (c *FakeClient) GetRegion() *server.Region {
+	return c.region
+}
+func (c *FakeClient) SetRegion(region *server.Region) {
+	c.region = region
+}
+
+// Returns the client's ID
+func (c *FakeClient) GetId() uint64 {
+	return c.id
+}
+
+// Initializes the client's state within the server
+func (c *FakeClient) Initialize(id uint64) {
+	// We store the new id as this client's ID
+	c.id = id
+	// Every log line of this client carries its ID from now on
+	c.logger = c.logger.With(logging.ClientId, id, "fake", true)
+
+	// When a new client connects, we switch to the connected state
+	c.SetState(&states.Connected{})
+}
+
+// Handles the packet that comes from the client
+func (c *FakeClient) ProcessPacket(senderId uint64, payload packets.Payload) {
+	// Packets can still be relayed to us while we are closing
+	if c.state != nil {
+		c.state.HandlePacket(senderId, payload)
+	}
+}
+
+// Sends a message to the client
+func (c *FakeClient) SendPacket(payload packets.Payload) {
+	c.SendPacketAs(c.id, payload)
+}
+
+// This is useful when we want to forward a packet we received from another client
+func (c *FakeClient) SendPacketAs(senderId uint64, payload packets.Payload) {
+	// Same as the websocket client, packets that reach us after Close are dropped
+	select {
+	case <-c.done:
+		return
+	default:
+	}
+
+	select {
+	// We queue packets to be sent to the client
+	case c.sendChannel <- &packets.Packet{
+		SenderId: senderId,
+		Payload:  payload,
+	}:
+	// If the client's channel is full, we drop the packet and log a warning
+	// This is to prevent the server from blocking waiting for this client
+	default:
+		c.logger.Warn("Send channel full, dropping packet", logging.PacketType, packets.TypeName(payload))
+	}
+}
+
+// Forward packet's payload to a specific client by ID
+// Note: only works if both clients are in the same region!
+func (c *FakeClient) RelayPacket(peerId uint64, payload packets.Payload) {
+	// If this client is currently in a region
+	if c.region != nil {
+		// We look for the peer in the current region
+		peer, found := c.region.GetClient(peerId)
+		if found {
+			peer.ProcessPacket(c.id, payload)
+		}
+	}
+}
+
+// Convenience function to queue a packet up to be passed to every client except the sender
+// Note: only works if the client is logged in and in a region
+func (c *FakeClient) Broadcast(payload packets.Payload) {
+	// If this client is currently in a region
+	if c.region != nil {
+		// We send it to the broadcast channel of that region
+		c.region.BroadcastChannel <- &packets.Packet{
+			SenderId: c.id,
+			Payload:  payload,
+		}
+	}
+}
+
+// Returns the processing packets channel
+func (c *FakeClient) GetProcessingChannel() chan *packets.Packet {
+	return c.processingChannel
+}
+
+// Moves packets from the inbox into the processing channel until Disconnect() is called
+func (c *FakeClient) StartReadPump() {
+	// We defer closing this client so we clean up the same way a websocket would
+	defer func() {
+		c.Close("disconnected")
+	}()
+
+	for {
+		var packet *packets.Packet
+		select {
+		case packet = <-c.inbox:
+		case <-c.disconnected:
+			return
+		}
+
+		// Go through protobuf like the websocket does, so invalid packets fail here too
+		packet, err := roundTrip(packet)
+		if err != nil {
+			c.logger.Warn("Error unmarshaling data", "error", err)
+			continue
+		}
+
+		// Allows the client to lazily not set the sender ID when sending a message to the server
+		if packet.SenderId == 0 {
+			packet.SenderId = c.id
+		}
+
+		// Try putting this out to the Hub for processing
+		select {
+		case c.processingChannel <- packet:
+		// But if this client's channel is full, drop the packet
+		default:
+			c.logger.Warn("Processing channel full, dropping packet", logging.PacketType, packets.TypeName(packet.Payload))
+		}
+	}
+}
+
+// Moves packets from the send channel into the outbox until the client is closed
+func (c *FakeClient) StartWritePump() {
+	// Nothing else will be delivered after this
+	defer close(c.outbox)
+
+	for {
+		select {
+		case packet := <-c.sendChannel:
+			c.deliver(packet)
+		case <-c.done:
+			// Flush whatever was queued before we closed
+			for {
+				select {
+				case packet := <-c.sendChannel:
+					c.deliver(packet)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Serializes the packet like the websocket would and hands it to the test
+func (c *FakeClient) deliver(packet *packets.Packet) {
+	packet, err := roundTrip(packet)
+	// If we fail to serialize, we drop the packet and read the next one
+	if err != nil {
+		c.logger.Error("Error marshalling packet, dropping", logging.PacketType, packets.TypeName(packet.Payload), "error", err)
+		return
+	}
+
+	select {
+	case c.outbox <- packet:
+	default:
+		c.logger.Warn("Outbox full, dropping packet", logging.PacketType, packets.TypeName(packet.Payload))
+	}
+}
+
+// Cleans up the client and unregisters the client from the server
+// Both pumps and the server (kick, timeout) call this from their own goroutines, only the first call counts
+func (c *FakeClient) Close(reason string) {
+	c.closeOnce.Do(func() {
+		// The game state only changes on the hub goroutine, same as the websocket client
+		err := c.hub.Do(func() {
+			c.leave(reason)
+		})
+		if err != nil {
+			c.logger.Error("Hub did not take the disconnect, cleaning up outside of it", "error", err)
+			c.leave(reason)
+		}
+
+		// Stops the write pump and any pending Send()
+		close(c.done)
+
+		// If the server closed us (kick, timeout) the read pump is still waiting on the inbox
+		// and any Send() from the test would block forever
+		c.Disconnect()
+	})
+}
+
+// Saves the player and removes the client from the game, only call this from the hub goroutine
+func (c *FakeClient) leave(reason string) {
+	if c.GetPlayerCharacter() != nil {
+		// Server logging
+		c.logger.Info("Player "+reason, logging.Username, c.accountUsername, logging.RegionId, c.playerCharacter.GetRegionId())
+
+		// Store this player's character data on logout
+		err := c.GetHub().SaveCharacter(c)
+		if err != nil {
+			c.logger.Error("Failed to save character to database", logging.Username, c.accountUsername, "error", err)
+		}
+
+		// Broadcast to everyone that this client left before we remove it from the hub/region
+		c.Broadcast(packets.NewClientLeft(c.playerCharacter.Name))
+
+		// Tell our friends we went offline
+		c.GetHub().NotifyFriends(c, false)
+
+	} else { // If the client connected to the server but never logged in
+		c.logger.Info("Client " + reason)
+	}
+
+	// If we were at a region, remove the client from this region
+	if c.region != nil {
+		c.region.RemoveClientChannel <- c
+	}
+
+	// Unregister this username from our Hub's usernameToClient map
+	if username := c.GetAccountUsername(); username != "" {
+		c.GetHub().UnregisterUsername(username)
+	}
+
+	// Remove the client from the Hub, directly since the hub can't read its own RemoveClientChannel
+	c.hub.Clients.Remove(c.GetId())
+
+	// Remove the client's state before disconnection
+	c.SetState(nil)
+}
+
+func (c *FakeClient) SetState(state server.ClientState) {
+	// State names are used for debugging purposes
+	lastStateName := "None"
+
+	// If our current state is valid
+	if c.state != nil {
+		// call the OnExit code for the current state
+		lastStateName = c.state.GetName()
+		c.state.OnExit()
+	}
+
+	newStateName := "None"
+	// If the new state is valid
+	if state != nil {
+		newStateName = state.GetName()
+	}
+
+	// Server logging
+	if c.playerCharacter != nil {
+		c.logger.Debug("Switched state", "from", lastStateName, logging.State, newStateName)
+	}
+
+	// Replace the previous state with the new one inside this client
+	c.state = state
+
+	// If the client's new state is valid
+	if c.state != nil {
+		// Inject the client's data into the state and call the OnEnter code for the new state
+		c.state.SetClient(c)
+		c.state.OnEnter()
+	}
+}
+
+// Marshals and unmarshals a packet, the same work the websocket pumps do on both ends
+func roundTrip(packet *packets.Packet) (*packets.Packet, error) {
+	data, err := proto.Marshal(packet)
+	if err != nil {
+		return packet, err
+	}
+
+	copied := &packets.Packet{}
+	err = proto.Unmarshal(data, copied)
+	if err != nil {
+		return packet, err
+	}
+
+	return copied, nil
+}
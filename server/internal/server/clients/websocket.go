@@ -1,14 +1,20 @@
 package clients
 
 import (
+	"crypto/tls"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"net/http"
 	"server/internal/server"
+	"server/internal/server/logging"
+	"server/internal/server/metrics"
 	"server/internal/server/objects"
 	"server/internal/server/states"
 	"server/pkg/packets"
+	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"google.golang.org/protobuf/proto"
@@ -28,16 +34,20 @@ type WebSocketClient struct {
 	state             server.ClientState   // In what state the client is in
 	playerCharacter   *objects.Player      // The player's data is stored in his character
 	accountUsername   string               // Username of the account that is connected
-	logger            *log.Logger
+	recorder          *server.Recorder     // Records this session's packets, nil unless the server runs with -record
+	rateLimiter       *server.RateLimiter  // Token buckets per packet type, nil if rate limiting is disabled
+	closeOnce         sync.Once            // Both pumps and kicks try to close the client, only the first one counts
+	done              chan struct{}        // Closed by Close, stops the write pump and drops any later packet
+	logger            *slog.Logger
 }
 
 // Called from Hub.serve()
 // Static function used to create a new WebSocket client from an HTTP connection (which is what Godot will use)
 func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *http.Request) (server.Client, error) {
 	upgrader := websocket.Upgrader{
-		ReadBufferSize:  2048,                                       // 2KB
-		WriteBufferSize: 1024,                                       // 1KB
-		CheckOrigin:     func(_ *http.Request) bool { return true }, // FIX -> Validate request origin
+		ReadBufferSize:  hub.Config.ReadBufferSize,  // 2KB by default
+		WriteBufferSize: hub.Config.WriteBufferSize, // 1KB by default
+		CheckOrigin:     hub.CheckOrigin,            // Browsers only connect from allowed_origins (see origin.go)
 	}
 
 	// Upgrades the HTTP connection to a WebSocket connection
@@ -48,8 +58,17 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 		return nil, err
 	}
 
-	// Get the underlying net.Conn and set TCP_NODELAY
-	err = conn.UnderlyingConn().(*net.TCPConn).SetNoDelay(true)
+	// Bigger messages close the connection before we even try to unmarshal them
+	conn.SetReadLimit(hub.Config.MaxMessageSize)
+
+	// Get the underlying net.Conn and set TCP_NODELAY, with built-in TLS the TCP connection is wrapped
+	underlying := conn.UnderlyingConn()
+	if tlsConn, ok := underlying.(*tls.Conn); ok {
+		underlying = tlsConn.NetConn()
+	}
+	if tcpConn, ok := underlying.(*net.TCPConn); ok {
+		err = tcpConn.SetNoDelay(true)
+	}
 	if err != nil {
 		conn.Close() // Close the connection if we can't set NoDelay
 		return nil, fmt.Errorf("failed to set TCP_NODELAY: %v", err)
@@ -57,12 +76,14 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 
 	// If no errors were found, create a new WebSocketClient
 	client := &WebSocketClient{
-		hub:               hub,                             // Entry point for all connected clients
-		region:            nil,                             // Before login, the client's region is nil
-		connection:        conn,                            // Underlying WebSocket connection
-		sendChannel:       make(chan *packets.Packet, 256), // Buffered channel of 264 packets, drops packets if full
-		processingChannel: make(chan *packets.Packet, 128), // Buffered channel of 128 packets, drops packets if full
-		logger:            log.New(log.Writer(), "", log.LstdFlags),
+		hub:               hub,                                                         // Entry point for all connected clients
+		region:            nil,                                                         // Before login, the client's region is nil
+		connection:        conn,                                                        // Underlying WebSocket connection
+		sendChannel:       make(chan *packets.Packet, hub.Config.SendBufferSize),       // Buffered channel (256 by default), drops packets if full
+		processingChannel: make(chan *packets.Packet, hub.Config.ProcessingBufferSize), // Buffered channel (128 by default), drops packets if full
+		rateLimiter:       server.NewRateLimiter(&hub.Config.RateLimit),
+		done:              make(chan struct{}),
+		logger:            logging.For(logging.Clients),
 	}
 
 	return client, nil
@@ -114,9 +135,11 @@ func (c *WebSocketClient) GetId() uint64 {
 func (c *WebSocketClient) Initialize(id uint64) {
 	// We store the new id as this client's ID
 	c.id = id
-	// Improve the details of the logged data in the server console
-	prefix := fmt.Sprintf("Client %d [%s]: ", c.GetId(), "WebSocket")
-	c.logger.SetPrefix(prefix)
+	// Every log line of this client carries its ID from now on
+	c.logger = c.logger.With(logging.ClientId, id)
+
+	// Start recording before the first packet goes out
+	c.recorder = c.hub.StartRecording(id)
 
 	// When a new client connects, we switch to the connected state
 	c.SetState(&states.Connected{})
@@ -134,6 +157,14 @@ func (c *WebSocketClient) SendPacket(payload packets.Payload) {
 
 // This is useful when we want to forward a packet we received from another client
 func (c *WebSocketClient) SendPacketAs(senderId uint64, payload packets.Payload) {
+	// Regions and detached sends can still reach us for a while after we closed, nobody will write it anyway.
+	// The send channel is never closed for that same reason, sending on it would panic
+	select {
+	case <-c.done:
+		return
+	default:
+	}
+
 	select {
 	// We queue packets to be sent to the client
 	case c.sendChannel <- &packets.Packet{
@@ -143,7 +174,8 @@ func (c *WebSocketClient) SendPacketAs(senderId uint64, payload packets.Payload)
 	// If the client's channel is full, we drop the packet and log a warning
 	// This is to prevent the server from blocking waiting for this client
 	default:
-		c.logger.Printf("Client %d send channel full, dropping packet: %T", c.id, payload)
+		c.logger.Warn("Send channel full, dropping packet", logging.PacketType, packets.TypeName(payload))
+		metrics.PacketsDropped.Inc("send", packets.TypeName(payload))
 	}
 }
 
@@ -189,8 +221,10 @@ func (c *WebSocketClient) StartReadPump() {
 	for {
 		_, data, err := c.connection.ReadMessage()
 		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				c.logger.Printf("error: %v", err)
+			if errors.Is(err, websocket.ErrReadLimit) {
+				c.logger.Warn("Message bigger than max_message_size, closing", "limit", c.hub.Config.MaxMessageSize)
+			} else if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				c.logger.Warn("Unexpected close", "error", err)
 			}
 			break
 		}
@@ -200,7 +234,7 @@ func (c *WebSocketClient) StartReadPump() {
 		err = proto.Unmarshal(data, packet)
 		// If an error occurs, we drop the packet and try to read the next one
 		if err != nil {
-			c.logger.Printf("Error unmarshaling data: %v", err)
+			c.logger.Warn("Error unmarshaling data", "error", err)
 			continue
 		}
 
@@ -209,12 +243,30 @@ func (c *WebSocketClient) StartReadPump() {
 			packet.SenderId = c.id
 		}
 
+		c.recorder.Record(c.id, false, packet)
+		packetType := packets.TypeName(packet.Payload)
+		metrics.PacketsReceived.Inc(packetType)
+
+		// Over its rate limit the packet is dropped here, so floods never fill the processing channel
+		action := c.rateLimiter.Check(packetType, time.Now())
+		if action != server.RateLimitAllow {
+			metrics.PacketsDropped.Inc("rate_limit", packetType)
+			switch action {
+			case server.RateLimitWarn:
+				c.hub.WarnForFlooding(c, packetType)
+			case server.RateLimitKick:
+				c.hub.KickForFlooding(c, packetType)
+			}
+			continue
+		}
+
 		// Try putting this out to the Hub for processing
 		select {
 		case c.processingChannel <- packet:
 		// But if this client's channel is full, drop the packet coming from Godot
 		default:
-			c.logger.Printf("Client %d processing channel full, dropping packet: %T", c.id, packet.Payload)
+			c.logger.Warn("Processing channel full, dropping packet", logging.PacketType, packetType)
+			metrics.PacketsDropped.Inc("processing", packetType)
 		}
 	}
 }
@@ -226,13 +278,22 @@ func (c *WebSocketClient) StartWritePump() {
 		c.Close("disconnected")
 	}()
 
-	// Go over every message in the client's send channel
-	for packet := range c.sendChannel {
+	// Go over every message in the client's send channel until the client is closed
+	for {
+		var packet *packets.Packet
+		select {
+		case packet = <-c.sendChannel:
+		case <-c.done:
+			return
+		}
+
+		c.recorder.Record(c.id, true, packet)
+
 		// Create a binary writer since Protobuf messages are binary
 		writer, err := c.connection.NextWriter(websocket.BinaryMessage)
 		// If we find an error, break out of this loop
 		if err != nil {
-			c.logger.Printf("Error getting writer for %T packet, closing client: %v", packet.Payload, err)
+			c.logger.Warn("Error getting writer, closing client", logging.PacketType, packets.TypeName(packet.Payload), "error", err)
 			return
 		}
 
@@ -240,7 +301,7 @@ func (c *WebSocketClient) StartWritePump() {
 		data, marshallErr := proto.Marshal(packet)
 		// If we fail to serialize, we drop the packet and read the next one
 		if marshallErr != nil {
-			c.logger.Printf("Error marshalling %T packet, dropping: %v", packet.Payload, marshallErr)
+			c.logger.Error("Error marshalling packet, dropping", logging.PacketType, packets.TypeName(packet.Payload), "error", marshallErr)
 			continue
 		}
 
@@ -249,7 +310,7 @@ func (c *WebSocketClient) StartWritePump() {
 
 		// If we fail to write this data to the websocket, we drop the packet and read the next one
 		if writeErr != nil {
-			c.logger.Printf("Error writing %T packet: %v", packet.Payload, writeErr)
+			c.logger.Warn("Error writing packet", logging.PacketType, packets.TypeName(packet.Payload), "error", writeErr)
 			continue
 		}
 
@@ -259,29 +320,59 @@ func (c *WebSocketClient) StartWritePump() {
 		// There can be at most, one open writer per connection, so we try to close this writer
 		closeErr := writer.Close()
 		if closeErr != nil {
-			c.logger.Printf("Error closing writer, dropping %T packet: %v", packet.Payload, closeErr)
+			c.logger.Warn("Error closing writer, dropping packet", logging.PacketType, packets.TypeName(packet.Payload), "error", closeErr)
 			continue
 		}
+
+		metrics.PacketsSent.Inc(packets.TypeName(packet.Payload))
 	}
 }
 
 // Cleans up the client's connection and unregisters the client from the server
+// Both pumps and kicks call this from their own goroutines, only the first call counts
 func (c *WebSocketClient) Close(reason string) {
+	c.closeOnce.Do(func() {
+		// The game state only changes on the hub goroutine
+		err := c.hub.Do(func() {
+			c.leave(reason)
+		})
+		// Better to clean up from here than to leave a ghost player behind if the hub is stuck
+		if err != nil {
+			c.logger.Error("Hub did not take the disconnect, cleaning up outside of it", "error", err)
+			c.leave(reason)
+		}
+
+		// close the client's websocket connection
+		c.connection.Close()
+
+		// Flush what we recorded of this session
+		c.recorder.Close()
+
+		// Stops the write pump, packets queued after this are dropped by SendPacketAs
+		close(c.done)
+	})
+}
+
+// Saves the player and removes the client from the game, only call this from the hub goroutine
+func (c *WebSocketClient) leave(reason string) {
 	if c.GetPlayerCharacter() != nil {
 		// Server logging
-		c.logger.Printf("%s %s", c.playerCharacter.Name, reason)
+		c.logger.Info("Player "+reason, logging.Username, c.accountUsername, logging.RegionId, c.playerCharacter.GetRegionId())
 
 		// Store this player's character data on logout
 		err := c.GetHub().SaveCharacter(c)
 		if err != nil {
-			c.logger.Printf("Failed to save character to database: %v", err)
+			c.logger.Error("Failed to save character to database", logging.Username, c.accountUsername, "error", err)
 		}
 
 		// Broadcast to everyone that this client left before we remove it from the hub/region
 		c.Broadcast(packets.NewClientLeft(c.playerCharacter.Name))
 
+		// Tell our friends we went offline
+		c.GetHub().NotifyFriends(c, false)
+
 	} else { // If the client connected to the server but never logged in
-		c.logger.Println("Client", reason)
+		c.logger.Info("Client " + reason)
 	}
 
 	// If we were at a region, remove the client from this region
@@ -294,20 +385,11 @@ func (c *WebSocketClient) Close(reason string) {
 		c.GetHub().UnregisterUsername(username)
 	}
 
-	// Remove the client from the Hub
-	c.hub.RemoveClientChannel <- c
+	// Remove the client from the Hub, directly since the hub can't read its own RemoveClientChannel
+	c.hub.Clients.Remove(c.GetId())
 
 	// Remove the client's state before disconnection
 	c.SetState(nil)
-
-	// close the client's websocket connection
-	c.connection.Close()
-
-	// We check if the client's send channel is closed, if its not, we close it
-	_, closed := <-c.sendChannel
-	if !closed {
-		close(c.sendChannel)
-	}
 }
 
 func (c *WebSocketClient) SetState(state server.ClientState) {
@@ -329,11 +411,12 @@ func (c *WebSocketClient) SetState(state server.ClientState) {
 
 	// Server logging
 	if c.playerCharacter != nil {
-		c.logger.Printf("%s switched from %s to %s", c.playerCharacter.Name, lastStateName, newStateName)
+		c.logger.Debug("Switched state", "from", lastStateName, logging.State, newStateName)
 	}
 
 	// Replace the previous state with the new one inside this client
 	c.state = state
+	c.recorder.SetState(newStateName)
 
 	// If the client's new state is valid
 	if c.state != nil {
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"server/internal/server/pathfinding"
+	"server/pkg/packets"
+	"time"
+)
+
+// Rolls and applies the damage of every hit a weapon landed on a player, used for players and NPCs alike
+// Each entry in criticalHits is one hit, true if it was critical (headshot)
+func (r *Region) DamagePlayer(target Client, attackerId uint64, killerCharacterId int64, attackerCell *pathfinding.Cell, weaponName string, criticalHits []bool) {
+	targetPlayer := target.GetPlayerCharacter()
+	// If the target is already dead, ignore this damage
+	if !targetPlayer.IsAlive() {
+		return
+	}
+
+	// Players that just respawned can't be hurt until their protection runs out or they fire
+	if targetPlayer.IsSpawnProtected(time.Now()) {
+		return
+	}
+
+	// Matches can protect teammates and stop all damage once they end
+	if r.Match != nil && !r.Match.CanDamage(attackerId, target.GetId()) {
+		return
+	}
+
+	// Crouching behind cover can stop the hits coming from the covered side
+	blockChance := r.getCoverBlockChance(targetPlayer.GetGridPosition(), attackerCell, targetPlayer.IsCrouching())
+	criticalHits = r.filterCoveredHits(criticalHits, blockChance)
+	if len(criticalHits) == 0 {
+		return
+	}
+
+	// Calculate total damage from all hits
+	var totalDamage uint64 = 0
+	var totalMitigated uint64 = 0
+	var anyCritical bool = false
+	var headshots uint64 = 0
+	var armorHit [objects.MAX_ARMOR_SLOTS]bool
+
+	// Calculate damage for each hit
+	for _, isCritical := range criticalHits {
+		var damage uint64 = objects.RollWeaponDamage(weaponName)
+		// Apply critical multipler if this hit was critical
+		if isCritical {
+			damage = damage * 2
+			anyCritical = true
+			headshots++
+		}
+
+		// Armor absorbs part of each hit, critical hits are headshots so they hit the helmet
+		taken, mitigated, armorSlot := targetPlayer.MitigateDamage(damage, objects.DAMAGE_TYPE_BULLET, isCritical)
+		if mitigated > 0 {
+			armorHit[armorSlot] = true
+		}
+
+		totalDamage += taken
+		totalMitigated += mitigated
+	}
+
+	// Apply total damage to target
+	targetPlayer.DecreaseHealth(totalDamage)
+	// Taking damage interrupts any item the target is using
+	r.CancelItemUse(target, "damage")
+
+	// Record the damage in the combat stats of both players (NPCs have no stats)
+	targetPlayer.GetUnsavedStats().DamageTaken += totalDamage
+	if attacker, exists := r.GetClient(attackerId); exists && attackerId != target.GetId() {
+		attacker.GetPlayerCharacter().GetUnsavedStats().RecordHits(weaponName, uint64(len(criticalHits)), headshots, totalDamage)
+	}
+
+	// Report the durability left in the armor pieces that absorbed damage
+	var armorPieces []*packets.ArmorPiece
+	for slot, wasHit := range armorHit {
+		if wasHit {
+			armorPieces = append(armorPieces, packets.NewArmorPiece(uint64(slot), targetPlayer.GetArmor(uint64(slot))))
+		}
+	}
+
+	// Tell everyone to apply the damage (including the attacker)
+	r.SendToAll(packets.NewApplyPlayerDamage(
+		attackerId,
+		target.GetId(),
+		totalDamage,
+		objects.DAMAGE_TYPE_BULLET, // TO FIX -> CAUTION, EACH WEAPON SHOULD HAVE DAMAGE TOO
+		anyCritical,
+		totalMitigated,
+		armorPieces,
+	))
+
+	// If the player died, only the killer can loot what they dropped for a while
+	if !targetPlayer.IsAlive() {
+		r.KillPlayer(target, attackerId, killerCharacterId, weaponName, anyCritical)
+		return
+	}
+
+	// Some weapons can apply a status effect on hit (shotgun pellets cause bleeding)
+	weaponStats, _ := objects.GetWeaponStats(weaponName)
+	r.ApplyWeaponHitEffects(target, weaponStats, len(criticalHits))
+}
+
+// Rolls and applies the damage of every hit a player landed on an NPC
+func (r *Region) DamageNPC(attacker Client, npc *objects.NPC, weaponName string, criticalHits []bool) {
+	if !npc.IsAlive() {
+		return
+	}
+
+	// NPCs hiding behind cover are protected the same way players are
+	attackerCell := attacker.GetPlayerCharacter().GetGridPosition()
+	criticalHits = r.filterCoveredHits(criticalHits, r.getCoverBlockChance(npc.GetGridPosition(), attackerCell, npc.IsCrouching()))
+	if len(criticalHits) == 0 {
+		return
+	}
+
+	now := time.Now()
+	var totalDamage uint64 = 0
+	var totalMitigated uint64 = 0
+	var anyCritical bool = false
+	var headshots uint64 = 0
+
+	for _, isCritical := range criticalHits {
+		var damage uint64 = objects.RollWeaponDamage(weaponName)
+		if isCritical {
+			damage = damage * 2
+			anyCritical = true
+			headshots++
+		}
+
+		// Blocking NPCs take half damage
+		if npc.IsBlocking(now) {
+			totalMitigated += damage / 2
+			damage -= damage / 2
+		}
+
+		totalDamage += damage
+	}
+
+	npc.DecreaseHealth(totalDamage)
+	attacker.GetPlayerCharacter().GetUnsavedStats().RecordHits(weaponName, uint64(len(criticalHits)), headshots, totalDamage)
+	npc.LastDamagedAt = now
+	// Whoever shoots us becomes our target
+	npc.TargetId = attacker.GetId()
+
+	r.SendToAll(packets.NewApplyPlayerDamage(
+		attacker.GetId(),
+		npc.Id,
+		totalDamage,
+		objects.DAMAGE_TYPE_BULLET,
+		anyCritical,
+		totalMitigated,
+		nil,
+	))
+
+	if !npc.IsAlive() {
+		r.KillNPC(npc, attacker.GetId(), attacker.GetCharacterId(), weaponName, anyCritical)
+	}
+}
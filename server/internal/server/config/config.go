@@ -0,0 +1,375 @@
+// Server settings, loaded once at startup from an optional JSON file. Any value can be
+// overridden with an environment variable named after its JSON key, OUTSTAR_ plus the key in
+// uppercase (nested keys are joined with _), e.g. OUTSTAR_SERVER_TICK=20 or OUTSTAR_LOG_FORMAT=json.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"reflect"
+	"server/internal/server/logging"
+	"server/pkg/packets"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Every environment variable override starts with this
+const EnvPrefix string = "OUTSTAR_"
+
+type Config struct {
+	// Network
+	Port            int    `json:"port"`
+	AdminAddr       string `json:"admin_addr"`        // Admin API listener, see AdminHandler
+	AdminToken      string `json:"admin_token"`       // The admin API is disabled while empty
+	MetricsAddr     string `json:"metrics_addr"`      // Prometheus /metrics listener, keep it private (disabled if empty)
+	ReadBufferSize  int    `json:"read_buffer_size"`  // Bytes, websocket read buffer
+	WriteBufferSize int    `json:"write_buffer_size"` // Bytes, websocket write buffer
+
+	// Websocket endpoint, only needed without a reverse proxy in front (see docs/setup_reverse_proxy.txt)
+	WsPath         string   `json:"ws_path"`         // Path the game clients connect to
+	AllowedOrigins []string `json:"allowed_origins"` // Browser origins allowed to connect, empty or "*" is any
+	TLSCertFile    string   `json:"tls_cert_file"`   // Serves wss:// and https:// with this certificate, reloaded when the file changes
+	TLSKeyFile     string   `json:"tls_key_file"`
+
+	// Hub loop
+	ServerTick       int `json:"server_tick"`        // Hub ticks per second
+	PacketsPerClient int `json:"packets_per_client"` // Packets processed for each client on every tick
+
+	// Clients
+	SendBufferSize       int      `json:"send_buffer_size"`       // Packets queued for the client before we drop them
+	ProcessingBufferSize int      `json:"processing_buffer_size"` // Packets queued for the hub before we drop them
+	DisconnectTimeout    Duration `json:"disconnect_timeout"`     // Idle time allowed before logging in
+	MaxMessageSize       int64    `json:"max_message_size"`       // Bytes, a bigger websocket message closes the connection
+
+	// Flood protection, see ratelimit.go
+	RateLimit RateLimitConfig `json:"rate_limit"`
+
+	// Storage
+	DatabasePath string `json:"database_path"` // Empty puts db.sqlite next to the executable
+	BcryptCost   int    `json:"bcrypt_cost"`   // Cost of new password hashes, existing ones keep theirs
+	RecordFolder string `json:"record_folder"` // Records client sessions here (disabled if empty)
+	DataFolder   string `json:"data_folder"`   // Game data files (weapons, respawners, chat filter), empty uses a data folder next to the executable
+
+	Log LogConfig `json:"log"`
+}
+
+type RateLimitConfig struct {
+	Enabled     bool              `json:"enabled"`
+	Default     Bucket            `json:"default"`      // Every packet type without its own entry in packets
+	Packets     map[string]Bucket `json:"packets"`      // Keyed by packet type, e.g. PublicMessage or LoginRequest
+	Window      Duration          `json:"window"`       // Dropped packets are counted over this long
+	WarnAfter   int               `json:"warn_after"`   // Dropped packets in a window before the player is warned
+	KickAfter   int               `json:"kick_after"`   // Dropped packets in a window before the client is kicked, 0 never kicks
+	BanAfter    int               `json:"ban_after"`    // Flooding kicks within ban_window before the account is banned, 0 never bans
+	BanWindow   Duration          `json:"ban_window"`   // Flooding kicks are remembered this long
+	BanDuration Duration          `json:"ban_duration"` // How long the temporary ban lasts
+}
+
+// Token bucket, holds up to burst packets and refills rate packets per second
+type Bucket struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+type LogConfig struct {
+	Format     string `json:"format"`      // text or json
+	Level      string `json:"level"`       // e.g. info,regions=debug
+	File       string `json:"file"`        // Writes to stderr if empty
+	MaxSize    int64  `json:"max_size"`    // Megabytes before the file is rotated, 0 never rotates
+	MaxBackups int    `json:"max_backups"` // Rotated files kept
+}
+
+// What the server used before it had a config file
+func Default() *Config {
+	return &Config{
+		Port:                 31591,
+		AdminAddr:            "127.0.0.1:31592",
+		MetricsAddr:          "127.0.0.1:31593",
+		ReadBufferSize:       2048, // 2KB
+		WriteBufferSize:      1024, // 1KB
+		WsPath:               "/ws",
+		AllowedOrigins:       []string{},
+		ServerTick:           30, // 30 Hz
+		PacketsPerClient:     5,
+		SendBufferSize:       256,
+		ProcessingBufferSize: 128,
+		DisconnectTimeout:    Duration{2 * time.Minute},
+		MaxMessageSize:       8192, // 8KB, the biggest client packets are a few hundred bytes
+		BcryptCost:           bcrypt.DefaultCost,
+		RateLimit: RateLimitConfig{
+			Enabled: true,
+			Default: Bucket{Rate: 20, Burst: 40},
+			// Generous for what the game sends while playing, tight for what is expensive (bcrypt) or annoying (chat)
+			Packets: map[string]Bucket{
+				"Heartbeat":          {Rate: 1, Burst: 5},
+				"LoginRequest":       {Rate: 0.5, Burst: 5},
+				"RegisterRequest":    {Rate: 0.2, Burst: 3},
+				"PublicMessage":      {Rate: 1, Burst: 5},
+				"GuildMessage":       {Rate: 1, Burst: 5},
+				"Destination":        {Rate: 30, Burst: 60},
+				"RotateCharacter":    {Rate: 60, Burst: 120},
+				"FireWeapon":         {Rate: 30, Burst: 60},
+				"FireWeaponMultiple": {Rate: 30, Burst: 60},
+				"ReportPlayerDamage": {Rate: 60, Burst: 120},
+			},
+			Window:      Duration{10 * time.Second},
+			WarnAfter:   20,
+			KickAfter:   200,
+			BanAfter:    3,
+			BanWindow:   Duration{30 * time.Minute},
+			BanDuration: Duration{15 * time.Minute},
+		},
+		Log: LogConfig{
+			Format:     "text",
+			Level:      "info",
+			MaxSize:    100,
+			MaxBackups: 5,
+		},
+	}
+}
+
+// Defaults, then the file (if any), then the environment
+func Load(path string) (*Config, error) {
+	config := Default()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading config file: %w", err)
+		}
+		// Unknown keys are most likely typos, better to fail than to silently use a default
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(config); err != nil {
+			return nil, fmt.Errorf("parsing config file %s: %w", path, err)
+		}
+	}
+
+	if err := config.applyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Returns every problem at once so the config can be fixed in one go
+func (c *Config) Validate() error {
+	problems := []error{}
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Port > 0 && c.Port <= 65535, "port must be between 1 and 65535, got %d", c.Port)
+	check(c.ReadBufferSize >= 128, "read_buffer_size must be at least 128 bytes, got %d", c.ReadBufferSize)
+	check(c.WriteBufferSize >= 128, "write_buffer_size must be at least 128 bytes, got %d", c.WriteBufferSize)
+	check(strings.HasPrefix(c.WsPath, "/"), "ws_path must start with /, got %q", c.WsPath)
+	check((c.TLSCertFile == "") == (c.TLSKeyFile == ""), "tls_cert_file and tls_key_file must be set together")
+	for _, origin := range c.AllowedOrigins {
+		check(validOrigin(origin), "allowed_origins: %q must be \"*\" or a scheme and host like \"https://play.example.com\" or \"https://*.example.com\"", origin)
+	}
+	check(c.ServerTick >= 1 && c.ServerTick <= 1000, "server_tick must be between 1 and 1000 Hz, got %d", c.ServerTick)
+	check(c.PacketsPerClient >= 1, "packets_per_client must be at least 1, got %d", c.PacketsPerClient)
+	check(c.SendBufferSize >= 1, "send_buffer_size must be at least 1, got %d", c.SendBufferSize)
+	check(c.ProcessingBufferSize >= c.PacketsPerClient, "processing_buffer_size must be at least packets_per_client (%d), got %d", c.PacketsPerClient, c.ProcessingBufferSize)
+	check(c.DisconnectTimeout.Duration >= time.Second, "disconnect_timeout must be at least 1s, got %v", c.DisconnectTimeout)
+	check(c.MaxMessageSize >= 512, "max_message_size must be at least 512 bytes, got %d", c.MaxMessageSize)
+	problems = append(problems, c.RateLimit.validate()...)
+	check(c.BcryptCost >= bcrypt.MinCost && c.BcryptCost <= bcrypt.MaxCost, "bcrypt_cost must be between %d and %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, c.BcryptCost)
+	check(c.Log.Format == "text" || c.Log.Format == "json", "log.format must be text or json, got %q", c.Log.Format)
+	check(c.Log.MaxSize >= 0, "log.max_size can't be negative, got %d", c.Log.MaxSize)
+	check(c.Log.MaxBackups >= 0, "log.max_backups can't be negative, got %d", c.Log.MaxBackups)
+	if _, _, err := logging.ParseLevels(c.Log.Level); err != nil {
+		problems = append(problems, fmt.Errorf("log.level: %w", err))
+	}
+
+	return errors.Join(problems...)
+}
+
+// Only checks what is used, a disabled rate limit can be left half configured
+func (r *RateLimitConfig) validate() []error {
+	problems := []error{}
+	if !r.Enabled {
+		return problems
+	}
+	checkBucket := func(name string, bucket Bucket) {
+		if bucket.Rate <= 0 || bucket.Burst < 1 {
+			problems = append(problems, fmt.Errorf("%s needs a rate above 0 and a burst of at least 1, got %v and %d", name, bucket.Rate, bucket.Burst))
+		}
+	}
+
+	checkBucket("rate_limit.default", r.Default)
+	known := packets.TypeNames()
+	for _, packetType := range slices.Sorted(maps.Keys(r.Packets)) {
+		if !slices.Contains(known, packetType) {
+			problems = append(problems, fmt.Errorf("rate_limit.packets: unknown packet type %q", packetType))
+			continue
+		}
+		checkBucket("rate_limit.packets."+packetType, r.Packets[packetType])
+	}
+	if r.Window.Duration < time.Second {
+		problems = append(problems, fmt.Errorf("rate_limit.window must be at least 1s, got %v", r.Window))
+	}
+	if r.WarnAfter < 1 {
+		problems = append(problems, fmt.Errorf("rate_limit.warn_after must be at least 1, got %d", r.WarnAfter))
+	}
+	if r.KickAfter < 0 || r.KickAfter > 0 && r.KickAfter <= r.WarnAfter {
+		problems = append(problems, fmt.Errorf("rate_limit.kick_after must be 0 (never) or more than warn_after (%d), got %d", r.WarnAfter, r.KickAfter))
+	}
+	if r.BanAfter < 0 {
+		problems = append(problems, fmt.Errorf("rate_limit.ban_after can't be negative, got %d", r.BanAfter))
+	}
+	if r.BanAfter > 0 && (r.BanWindow.Duration <= 0 || r.BanDuration.Duration <= 0) {
+		problems = append(problems, fmt.Errorf("rate_limit.ban_window and rate_limit.ban_duration must be above 0 when ban_after is set"))
+	}
+	return problems
+}
+
+// Settings for logging.Setup
+func (c *Config) Logging() logging.Config {
+	level, levels, _ := logging.ParseLevels(c.Log.Level) // Checked by Validate
+	return logging.Config{
+		Format:     c.Log.Format,
+		Level:      level,
+		Levels:     levels,
+		File:       c.Log.File,
+		MaxSize:    c.Log.MaxSize * 1024 * 1024,
+		MaxBackups: c.Log.MaxBackups,
+	}
+}
+
+// Time between hub ticks
+func (c *Config) TickInterval() time.Duration {
+	return time.Second / time.Duration(c.ServerTick)
+}
+
+// The effective config as indented JSON, secrets are hidden
+func (c *Config) Dump() ([]byte, error) {
+	dumped := *c
+	if dumped.AdminToken != "" {
+		dumped.AdminToken = "<redacted>"
+	}
+	// No HTML escaping, the output is for people and <redacted> should read as such
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(&dumped); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Origins are what browsers send in the Origin header, scheme://host[:port] and nothing else
+func validOrigin(origin string) bool {
+	if origin == "*" {
+		return true
+	}
+	parsed, err := url.Parse(origin)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return false
+	}
+	return parsed.Path == "" && parsed.RawQuery == "" && parsed.Fragment == "" && parsed.User == nil
+}
+
+// Goes over every field and looks for its environment variable
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	return applyEnv(reflect.ValueOf(c).Elem(), EnvPrefix, lookup)
+}
+
+func applyEnv(value reflect.Value, prefix string, lookup func(string) (string, bool)) error {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if key == "" || key == "-" {
+			continue
+		}
+		name := prefix + strings.ToUpper(key)
+		target := value.Field(i)
+
+		// Nested sections, e.g. log.format is OUTSTAR_LOG_FORMAT
+		if target.Kind() == reflect.Struct && target.Type() != reflect.TypeOf(Duration{}) {
+			if err := applyEnv(target, name+"_", lookup); err != nil {
+				return err
+			}
+			continue
+		}
+
+		raw, found := lookup(name)
+		if !found {
+			continue
+		}
+
+		switch target.Addr().Interface().(type) {
+		case *Duration:
+			duration, err := time.ParseDuration(raw)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			target.Set(reflect.ValueOf(Duration{duration}))
+		case *string:
+			target.SetString(raw)
+		case *bool:
+			enabled, err := strconv.ParseBool(raw)
+			if err != nil {
+				return fmt.Errorf("%s must be true or false, got %q", name, raw)
+			}
+			target.SetBool(enabled)
+		case *float64:
+			number, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return fmt.Errorf("%s must be a number, got %q", name, raw)
+			}
+			target.SetFloat(number)
+		case *int, *int64:
+			number, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("%s must be a number, got %q", name, raw)
+			}
+			target.SetInt(number)
+		case *[]string:
+			// Comma separated, e.g. OUTSTAR_ALLOWED_ORIGINS=https://a.example.com,https://b.example.com
+			list := []string{}
+			for _, item := range strings.Split(raw, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					list = append(list, item)
+				}
+			}
+			target.Set(reflect.ValueOf(list))
+		default:
+			return fmt.Errorf("%s: unsupported config type %s", name, target.Type())
+		}
+	}
+	return nil
+}
+
+// time.Duration written as "2m30s" in the file instead of nanoseconds
+type Duration struct {
+	time.Duration
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("durations are strings like \"2m\" or \"30s\"")
+	}
+	duration, err := time.ParseDuration(text)
+	if err != nil {
+		return err
+	}
+	d.Duration = duration
+	return nil
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// Starts using one unit of the item in that inventory slot, it takes effect once its use duration is over
+func (r *Region) StartItemUse(client Client, slot uint64) error {
+	player := client.GetPlayerCharacter()
+	if !player.IsAlive() {
+		return errors.New("dead players can't use items")
+	}
+	if player.GetItemUse() != nil {
+		return errors.New("already using an item")
+	}
+
+	item := player.GetInventory().GetSlot(slot)
+	if item == nil {
+		return errors.New("slot is empty")
+	}
+
+	stats, exists := objects.GetItemStats(item.ItemName)
+	if !exists || !stats.IsUsable() {
+		return fmt.Errorf("%s can't be used", stats.DisplayName)
+	}
+
+	now := time.Now()
+	if player.IsItemOnCooldown(item.ItemName, now) {
+		return fmt.Errorf("%s is on cooldown", stats.DisplayName)
+	}
+
+	// Don't waste the item if it wouldn't do anything
+	if err := canApplyItem(player, stats); err != nil {
+		return err
+	}
+
+	player.SetItemUse(&objects.ItemUse{
+		ItemName: item.ItemName,
+		FinishAt: now.Add(stats.UseDuration),
+	})
+
+	// Tell everyone so they can play the animation
+	itemUseStartedPacket := packets.NewItemUseStarted(item.ItemName, stats.UseDuration)
+	client.SendPacket(itemUseStartedPacket)
+	client.Broadcast(itemUseStartedPacket)
+
+	return nil
+}
+
+// Stops the item this client is using without consuming it, does nothing if they aren't using one
+func (r *Region) CancelItemUse(client Client, reason string) {
+	player := client.GetPlayerCharacter()
+	itemUse := player.GetItemUse()
+	if itemUse == nil {
+		return
+	}
+	player.SetItemUse(nil)
+
+	itemUseEndedPacket := packets.NewItemUseEnded(itemUse.ItemName, false, reason)
+	client.SendPacket(itemUseEndedPacket)
+	client.Broadcast(itemUseEndedPacket)
+}
+
+// Returns an error if using this item right now would have no effect
+func canApplyItem(player *objects.Player, stats objects.ItemStats) error {
+	if stats.HealAmount > 0 && player.GetHealth() >= player.GetMaxHealth() {
+		// Items that cure something are still useful at full health
+		cures := false
+		for _, effectName := range stats.Cures {
+			if player.GetStatusEffect(effectName) != nil {
+				cures = true
+			}
+		}
+		if !cures {
+			return errors.New("already at full health")
+		}
+	}
+	if stats.Type == objects.ITEM_TYPE_AMMO {
+		if _, found := player.GetReserveAmmoSlot(stats.AmmoType); !found {
+			return errors.New("no weapon can take this ammo")
+		}
+	}
+	return nil
+}
+
+// Consumes the item and applies its effects once the use duration is over
+func (r *Region) finishItemUse(client Client, itemUse *objects.ItemUse, now time.Time) {
+	player := client.GetPlayerCharacter()
+	player.SetItemUse(nil)
+
+	stats, _ := objects.GetItemStats(itemUse.ItemName)
+	inventory := player.GetInventory()
+
+	// Validate again, things might have changed while we were using it
+	err := canApplyItem(player, stats)
+	if err == nil && !inventory.RemoveItem(itemUse.ItemName, 1) {
+		err = errors.New("item is gone")
+	}
+	if err != nil {
+		itemUseEndedPacket := packets.NewItemUseEnded(itemUse.ItemName, false, err.Error())
+		client.SendPacket(itemUseEndedPacket)
+		client.Broadcast(itemUseEndedPacket)
+		return
+	}
+
+	// Heal
+	if stats.HealAmount > 0 {
+		healthBefore := player.GetHealth()
+		player.IncreaseHealth(stats.HealAmount)
+
+		healPlayerPacket := packets.NewHealPlayer(client.GetId(), player.GetHealth()-healthBefore, player.GetHealth(), itemUse.ItemName)
+		client.SendPacket(healPlayerPacket)
+		client.Broadcast(healPlayerPacket)
+	}
+
+	// Load the box into the reserve of a weapon that fires this ammo
+	// Any bullets that don't fit in the reserve are lost
+	if stats.Type == objects.ITEM_TYPE_AMMO {
+		weaponSlot, _ := player.AddReserveAmmo(stats.AmmoType, stats.AmmoAmount)
+
+		updateWeaponPacket := packets.NewUpdateWeaponSlot(weaponSlot, player.GetWeaponSlot(weaponSlot))
+		client.SendPacket(updateWeaponPacket)
+		client.Broadcast(updateWeaponPacket)
+	}
+
+	// Cure status effects
+	for _, effectName := range stats.Cures {
+		r.RemoveStatusEffect(client, effectName)
+	}
+
+	if stats.Cooldown > 0 {
+		player.SetItemCooldown(itemUse.ItemName, now.Add(stats.Cooldown))
+	}
+
+	itemUseEndedPacket := packets.NewItemUseEnded(itemUse.ItemName, true, "")
+	client.SendPacket(itemUseEndedPacket)
+	client.Broadcast(itemUseEndedPacket)
+	client.SendPacket(packets.NewInventorySnapshot(inventory))
+}
+
+// Finishes the item uses that are over, called every tick by the hub
+func (r *Region) updateItemUses(now time.Time) {
+	r.Clients.ForEach(func(id uint64, client Client) {
+		player := client.GetPlayerCharacter()
+		if player == nil {
+			return
+		}
+		itemUse := player.GetItemUse()
+		if itemUse != nil && itemUse.IsFinished(now) {
+			r.finishItemUse(client, itemUse, now)
+		}
+	})
+}
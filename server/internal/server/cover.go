@@ -0,0 +1,48 @@
+package server
+
+import (
+	"math/rand"
+	"server/internal/server/pathfinding"
+)
+
+const crouchCoverBlockChance float64 = 0.8 // Chance of full cover stopping a hit aimed at a crouching character
+
+// Static prop that blocks movement and gives cover, must match the props placed in the map scene
+type Obstacle struct {
+	Name  string
+	Cells []*pathfinding.Cell
+	Cover float64 // pathfinding.COVER_HALF or pathfinding.COVER_FULL
+}
+
+// Places this region's obstacles on the grid, before anyone spawns
+func (r *Region) PlaceObstacles() {
+	grid := r.GetGrid()
+	for _, obstacle := range r.Obstacles {
+		for _, cell := range obstacle.Cells {
+			grid.SetObstacle(grid.LocalToMap(cell.X, cell.Z), obstacle.Cover)
+		}
+	}
+}
+
+// Returns the chance of a hit being stopped by the cover between a crouching target and the attacker
+func (r *Region) getCoverBlockChance(targetCell, attackerCell *pathfinding.Cell, isCrouching bool) float64 {
+	if !isCrouching {
+		return 0
+	}
+	grid := r.GetGrid()
+	return grid.GetCoverFrom(targetCell, attackerCell) * crouchCoverBlockChance
+}
+
+// Removes the hits stopped by the target's cover
+func (r *Region) filterCoveredHits(criticalHits []bool, blockChance float64) []bool {
+	if blockChance <= 0 {
+		return criticalHits
+	}
+	var landed []bool
+	for _, isCritical := range criticalHits {
+		if rand.Float64() >= blockChance {
+			landed = append(landed, isCritical)
+		}
+	}
+	return landed
+}
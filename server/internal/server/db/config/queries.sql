@@ -37,12 +37,12 @@ SELECT * FROM characters WHERE user_id = ?;
 -- name: UpdateFullCharacterData :exec
 UPDATE characters
 SET
-  region_id = ?, map_id = ?, x = ?, z = ?, health = ?, max_health = ?, speed = ?, rotation_y = ?, weapon_slot = ?, is_crouching = ?
+  region_id = ?, map_id = ?, x = ?, z = ?, health = ?, max_health = ?, speed = ?, rotation_y = ?, weapon_slot = ?, is_crouching = ?, level = ?, experience = ?
 WHERE id = ?;
 
 -- name: GetFullCharacterData :one
 SELECT
-  c.id, c.gender, c.region_id, c.map_id, c.x, c.z, c.health, c.max_health, c.speed, c.rotation_y, c.weapon_slot, c.is_crouching,
+  c.id, c.gender, c.region_id, c.map_id, c.x, c.z, c.health, c.max_health, c.speed, c.rotation_y, c.weapon_slot, c.is_crouching, c.level, c.experience,
   u.username, u.nickname
 FROM characters c
 JOIN users u ON c.user_id = u.id
@@ -68,4 +68,208 @@ VALUES (?, ?, ?, ?, ?, ?, ?, ?);
 -- name: LoadWeaponSlots :many
 SELECT slot_index, weapon_name, weapon_type, display_name, ammo, reserve_ammo, fire_mode
 FROM character_weapons
-WHERE character_id = ?
\ No newline at end of file
+WHERE character_id = ?;
+
+-- Friend Operations
+-- name: CreateFriendRequest :exec
+INSERT INTO friend_requests (sender_id, receiver_id) VALUES (?, ?);
+
+-- name: DeleteFriendRequest :execrows
+DELETE FROM friend_requests WHERE sender_id = ? AND receiver_id = ?;
+
+-- name: HasFriendRequest :one
+SELECT COUNT(*) FROM friend_requests WHERE sender_id = ? AND receiver_id = ?;
+
+-- name: GetIncomingFriendRequests :many
+SELECT fr.sender_id, u.username, u.nickname
+FROM friend_requests fr
+JOIN characters c ON fr.sender_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE fr.receiver_id = ?;
+
+-- name: InsertFriend :exec
+INSERT INTO friends (character_id, friend_id) VALUES (?, ?);
+
+-- name: DeleteFriend :execrows
+DELETE FROM friends WHERE character_id = ? AND friend_id = ?;
+
+-- name: IsFriend :one
+SELECT COUNT(*) FROM friends WHERE character_id = ? AND friend_id = ?;
+
+-- name: CountFriends :one
+SELECT COUNT(*) FROM friends WHERE character_id = ?;
+
+-- name: GetFriends :many
+SELECT f.friend_id, u.username, u.nickname
+FROM friends f
+JOIN characters c ON f.friend_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE f.character_id = ?;
+
+-- Guild Operations
+-- name: CreateGuild :one
+INSERT INTO guilds (name, tag, leader_id) VALUES (?, ?, ?)
+RETURNING *;
+
+-- name: GetGuildByID :one
+SELECT * FROM guilds WHERE id = ?;
+
+-- name: UpdateGuildLeader :exec
+UPDATE guilds SET leader_id = ? WHERE id = ?;
+
+-- name: DeleteGuild :exec
+DELETE FROM guilds WHERE id = ?;
+
+-- name: UpsertGuildRank :exec
+INSERT INTO guild_ranks (guild_id, rank_index, name, permissions) VALUES (?, ?, ?, ?)
+ON CONFLICT (guild_id, rank_index) DO UPDATE SET
+name = excluded.name,
+permissions = excluded.permissions;
+
+-- name: GetGuildRanks :many
+SELECT rank_index, name, permissions FROM guild_ranks WHERE guild_id = ? ORDER BY rank_index;
+
+-- name: DeleteGuildRanks :exec
+DELETE FROM guild_ranks WHERE guild_id = ?;
+
+-- name: InsertGuildMember :exec
+INSERT INTO guild_members (character_id, guild_id, rank_index) VALUES (?, ?, ?);
+
+-- name: GetGuildMembership :one
+SELECT guild_id, rank_index FROM guild_members WHERE character_id = ?;
+
+-- name: GetGuildMembers :many
+SELECT gm.character_id, gm.rank_index, u.nickname
+FROM guild_members gm
+JOIN characters c ON gm.character_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE gm.guild_id = ?;
+
+-- name: UpdateGuildMemberRank :exec
+UPDATE guild_members SET rank_index = ? WHERE character_id = ?;
+
+-- name: DeleteGuildMember :exec
+DELETE FROM guild_members WHERE character_id = ?;
+
+-- name: DeleteGuildMembers :exec
+DELETE FROM guild_members WHERE guild_id = ?;
+
+-- Inventory Operations
+
+-- name: LoadInventoryItems :many
+SELECT slot_index, item_name, quantity, wear, ammo FROM character_inventory WHERE character_id = ? ORDER BY slot_index;
+
+-- name: DeleteInventoryItems :exec
+DELETE FROM character_inventory WHERE character_id = ?;
+
+-- name: InsertInventoryItem :exec
+INSERT INTO character_inventory (character_id, slot_index, item_name, quantity, wear, ammo) VALUES (?, ?, ?, ?, ?, ?);
+
+-- Armor Operations
+
+-- name: LoadArmorPieces :many
+SELECT slot_index, item_name, wear FROM character_armor WHERE character_id = ?;
+
+-- name: DeleteArmorPieces :exec
+DELETE FROM character_armor WHERE character_id = ?;
+
+-- name: InsertArmorPiece :exec
+INSERT INTO character_armor (character_id, slot_index, item_name, wear) VALUES (?, ?, ?, ?);
+
+-- Match Operations
+
+-- name: CreateMatch :one
+INSERT INTO matches (region_id, mode, started_at, ended_at, winner_team) VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: InsertMatchPlayer :exec
+INSERT INTO match_players (match_id, character_id, team, kills, deaths, won) VALUES (?, ?, ?, ?, ?, ?);
+
+-- Stats Operations
+
+-- name: AddCharacterStats :exec
+INSERT INTO character_stats (character_id, kills, npc_kills, deaths, damage_dealt, damage_taken, shots_fired, hits, headshots)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (character_id) DO UPDATE SET
+kills = kills + excluded.kills,
+npc_kills = npc_kills + excluded.npc_kills,
+deaths = deaths + excluded.deaths,
+damage_dealt = damage_dealt + excluded.damage_dealt,
+damage_taken = damage_taken + excluded.damage_taken,
+shots_fired = shots_fired + excluded.shots_fired,
+hits = hits + excluded.hits,
+headshots = headshots + excluded.headshots;
+
+-- name: AddCharacterWeaponStats :exec
+INSERT INTO character_weapon_stats (character_id, weapon_name, kills, damage_dealt, shots_fired, hits, headshots)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (character_id, weapon_name) DO UPDATE SET
+kills = kills + excluded.kills,
+damage_dealt = damage_dealt + excluded.damage_dealt,
+shots_fired = shots_fired + excluded.shots_fired,
+hits = hits + excluded.hits,
+headshots = headshots + excluded.headshots;
+
+-- name: GetCharacterStats :one
+SELECT * FROM character_stats WHERE character_id = ?;
+
+-- name: GetCharacterWeaponStats :many
+SELECT * FROM character_weapon_stats WHERE character_id = ? ORDER BY kills DESC, weapon_name;
+
+-- Leaderboard Operations
+
+-- name: GetTopKills :many
+SELECT s.character_id, u.nickname, s.kills, s.deaths
+FROM character_stats s
+JOIN characters c ON s.character_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE s.kills > 0
+ORDER BY s.kills DESC, s.deaths ASC
+LIMIT ?;
+
+-- name: GetTopKillDeathRatio :many
+SELECT s.character_id, u.nickname, s.kills, s.deaths
+FROM character_stats s
+JOIN characters c ON s.character_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE s.kills >= ?
+ORDER BY CAST(s.kills AS REAL) / MAX(s.deaths, 1) DESC, s.kills DESC
+LIMIT ?;
+
+-- name: GetTopLevels :many
+SELECT c.id AS character_id, u.nickname, c.level, c.experience
+FROM characters c
+JOIN users u ON c.user_id = u.id
+WHERE c.experience > 0
+ORDER BY c.level DESC, c.experience DESC
+LIMIT ?;
+
+-- name: GetTopMatchWins :many
+SELECT mp.character_id, u.nickname, CAST(SUM(mp.won) AS INTEGER) AS wins, COUNT(*) AS played
+FROM match_players mp
+JOIN characters c ON mp.character_id = c.id
+JOIN users u ON c.user_id = u.id
+GROUP BY mp.character_id, u.nickname
+HAVING SUM(mp.won) > 0
+ORDER BY wins DESC, played ASC
+LIMIT ?;
+
+-- Moderation Operations
+
+-- name: SetAccountRestriction :exec
+INSERT INTO account_restrictions (user_id, kind, reason, expires_at, created_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(user_id, kind) DO UPDATE SET
+  reason = excluded.reason,
+  expires_at = excluded.expires_at,
+  created_at = excluded.created_at;
+
+-- name: GetAccountRestriction :one
+SELECT reason, expires_at
+FROM account_restrictions
+WHERE user_id = ? AND kind = ?
+LIMIT 1;
+
+-- name: DeleteAccountRestriction :exec
+DELETE FROM account_restrictions
+WHERE user_id = ? AND kind = ?;
\ No newline at end of file
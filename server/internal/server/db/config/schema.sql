@@ -23,6 +23,8 @@ CREATE TABLE IF NOT EXISTS characters (
   rotation_y REAL NOT NULL DEFAULT 0.0,
   weapon_slot INTEGER NOT NULL DEFAULT 0 CHECK (weapon_slot BETWEEN 0 and 4), -- Clamp weapon slot
   is_crouching INTEGER NOT NULL DEFAULT 0, -- 0 = standing, 1 = crouching
+  level INTEGER NOT NULL DEFAULT 1,
+  experience INTEGER NOT NULL DEFAULT 0, -- Total earned, the level follows from it
   FOREIGN KEY (user_id) REFERENCES users(id) -- 1:1 relationship (only one character per user)
 );
 
@@ -38,4 +40,132 @@ CREATE TABLE IF NOT EXISTS character_weapons (
   display_name TEXT NOT NULL DEFAULT 'Empty',
   PRIMARY KEY (character_id, slot_index),
   FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
+
+-- General items each character carries outside of the weapon slots (game data)
+CREATE TABLE IF NOT EXISTS character_inventory (
+  character_id INTEGER NOT NULL,
+  slot_index INTEGER NOT NULL,
+  item_name TEXT NOT NULL,
+  quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
+  wear INTEGER NOT NULL DEFAULT 0, -- Durability lost by items that don't stack
+  ammo INTEGER NOT NULL DEFAULT 0, -- Bullets kept in a stored weapon
+  PRIMARY KEY (character_id, slot_index),
+  FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+-- Armor pieces equipped by each character (game data)
+CREATE TABLE IF NOT EXISTS character_armor (
+  character_id INTEGER NOT NULL,
+  slot_index INTEGER NOT NULL CHECK (slot_index BETWEEN 0 AND 1), -- 0: helmet, 1: vest
+  item_name TEXT NOT NULL,
+  wear INTEGER NOT NULL DEFAULT 0, -- Durability lost
+  PRIMARY KEY (character_id, slot_index),
+  FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+-- Pending friend requests between characters (social data)
+CREATE TABLE IF NOT EXISTS friend_requests (
+  sender_id INTEGER NOT NULL, -- Character that sent the request
+  receiver_id INTEGER NOT NULL, -- Character that has to accept or decline it
+  PRIMARY KEY (sender_id, receiver_id),
+  FOREIGN KEY (sender_id) REFERENCES characters(id) ON DELETE CASCADE,
+  FOREIGN KEY (receiver_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+-- Accepted friendships, stored once per direction so lookups only need one column (social data)
+CREATE TABLE IF NOT EXISTS friends (
+  character_id INTEGER NOT NULL,
+  friend_id INTEGER NOT NULL,
+  PRIMARY KEY (character_id, friend_id),
+  FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+  FOREIGN KEY (friend_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+-- Player-created organizations (social data)
+CREATE TABLE IF NOT EXISTS guilds (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+  tag TEXT NOT NULL UNIQUE COLLATE NOCASE, -- Short tag displayed next to the nickname
+  leader_id INTEGER NOT NULL, -- Character that owns this guild
+  FOREIGN KEY (leader_id) REFERENCES characters(id)
+);
+
+-- Ranks of each guild, rank 0 is always the leader (social data)
+CREATE TABLE IF NOT EXISTS guild_ranks (
+  guild_id INTEGER NOT NULL,
+  rank_index INTEGER NOT NULL CHECK (rank_index BETWEEN 0 AND 4), -- 0 to 4 (5 ranks)
+  name TEXT NOT NULL,
+  permissions INTEGER NOT NULL DEFAULT 0, -- Bitmask of guild permissions
+  PRIMARY KEY (guild_id, rank_index),
+  FOREIGN KEY (guild_id) REFERENCES guilds(id) ON DELETE CASCADE
+);
+
+-- Guild membership, a character can only belong to one guild (social data)
+CREATE TABLE IF NOT EXISTS guild_members (
+  character_id INTEGER NOT NULL PRIMARY KEY,
+  guild_id INTEGER NOT NULL,
+  rank_index INTEGER NOT NULL DEFAULT 4,
+  FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+  FOREIGN KEY (guild_id) REFERENCES guilds(id) ON DELETE CASCADE
+);
+-- Results of every finished match (match data)
+CREATE TABLE IF NOT EXISTS matches (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  region_id INTEGER NOT NULL,
+  mode TEXT NOT NULL, -- deathmatch, team_deathmatch
+  started_at INTEGER NOT NULL, -- Unix time when the live phase started
+  ended_at INTEGER NOT NULL, -- Unix time when the live phase ended
+  winner_team INTEGER NOT NULL DEFAULT 0 -- 0 if no team won (deathmatch or tie)
+);
+
+-- Score of each character that played a finished match (match data)
+CREATE TABLE IF NOT EXISTS match_players (
+  match_id INTEGER NOT NULL,
+  character_id INTEGER NOT NULL,
+  team INTEGER NOT NULL DEFAULT 0,
+  kills INTEGER NOT NULL DEFAULT 0,
+  deaths INTEGER NOT NULL DEFAULT 0,
+  won INTEGER NOT NULL DEFAULT 0, -- 1 if this character or their team won
+  PRIMARY KEY (match_id, character_id),
+  FOREIGN KEY (match_id) REFERENCES matches(id) ON DELETE CASCADE,
+  FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+-- Lifetime combat record of each character (stats data)
+CREATE TABLE IF NOT EXISTS character_stats (
+  character_id INTEGER NOT NULL PRIMARY KEY,
+  kills INTEGER NOT NULL DEFAULT 0, -- Other players killed
+  npc_kills INTEGER NOT NULL DEFAULT 0,
+  deaths INTEGER NOT NULL DEFAULT 0,
+  damage_dealt INTEGER NOT NULL DEFAULT 0,
+  damage_taken INTEGER NOT NULL DEFAULT 0,
+  shots_fired INTEGER NOT NULL DEFAULT 0,
+  hits INTEGER NOT NULL DEFAULT 0,
+  headshots INTEGER NOT NULL DEFAULT 0,
+  FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+-- Lifetime usage of each weapon by each character (stats data)
+CREATE TABLE IF NOT EXISTS character_weapon_stats (
+  character_id INTEGER NOT NULL,
+  weapon_name TEXT NOT NULL,
+  kills INTEGER NOT NULL DEFAULT 0, -- Players and NPCs
+  damage_dealt INTEGER NOT NULL DEFAULT 0,
+  shots_fired INTEGER NOT NULL DEFAULT 0,
+  hits INTEGER NOT NULL DEFAULT 0,
+  headshots INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (character_id, weapon_name),
+  FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+-- Bans and mutes given by the admins (moderation data)
+CREATE TABLE IF NOT EXISTS account_restrictions (
+  user_id INTEGER NOT NULL,
+  kind TEXT NOT NULL CHECK (kind IN ('ban', 'mute')),
+  reason TEXT NOT NULL DEFAULT '',
+  expires_at INTEGER, -- Unix time in seconds, NULL if it never expires
+  created_at INTEGER NOT NULL, -- Unix time in seconds
+  PRIMARY KEY (user_id, kind),
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -8,6 +8,14 @@ import (
 	"database/sql"
 )
 
+type AccountRestriction struct {
+	UserID    int64
+	Kind      string
+	Reason    string
+	ExpiresAt sql.NullInt64
+	CreatedAt int64
+}
+
 type Character struct {
 	ID          int64
 	UserID      int64
@@ -22,6 +30,36 @@ type Character struct {
 	RotationY   float64
 	WeaponSlot  int64
 	IsCrouching int64
+	Level       int64
+	Experience  int64
+}
+
+type CharacterArmor struct {
+	CharacterID int64
+	SlotIndex   int64
+	ItemName    string
+	Wear        int64
+}
+
+type CharacterInventory struct {
+	CharacterID int64
+	SlotIndex   int64
+	ItemName    string
+	Quantity    int64
+	Wear        int64
+	Ammo        int64
+}
+
+type CharacterStat struct {
+	CharacterID int64
+	Kills       int64
+	NpcKills    int64
+	Deaths      int64
+	DamageDealt int64
+	DamageTaken int64
+	ShotsFired  int64
+	Hits        int64
+	Headshots   int64
 }
 
 type CharacterWeapon struct {
@@ -35,6 +73,64 @@ type CharacterWeapon struct {
 	DisplayName string
 }
 
+type CharacterWeaponStat struct {
+	CharacterID int64
+	WeaponName  string
+	Kills       int64
+	DamageDealt int64
+	ShotsFired  int64
+	Hits        int64
+	Headshots   int64
+}
+
+type Friend struct {
+	CharacterID int64
+	FriendID    int64
+}
+
+type FriendRequest struct {
+	SenderID   int64
+	ReceiverID int64
+}
+
+type Guild struct {
+	ID       int64
+	Name     string
+	Tag      string
+	LeaderID int64
+}
+
+type GuildMember struct {
+	CharacterID int64
+	GuildID     int64
+	RankIndex   int64
+}
+
+type GuildRank struct {
+	GuildID     int64
+	RankIndex   int64
+	Name        string
+	Permissions int64
+}
+
+type Match struct {
+	ID         int64
+	RegionID   int64
+	Mode       string
+	StartedAt  int64
+	EndedAt    int64
+	WinnerTeam int64
+}
+
+type MatchPlayer struct {
+	MatchID     int64
+	CharacterID int64
+	Team        int64
+	Kills       int64
+	Deaths      int64
+	Won         int64
+}
+
 type User struct {
 	ID           int64
 	Username     string
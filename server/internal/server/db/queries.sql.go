@@ -10,10 +10,96 @@ import (
 	"database/sql"
 )
 
+const addCharacterStats = `-- name: AddCharacterStats :exec
+INSERT INTO character_stats (character_id, kills, npc_kills, deaths, damage_dealt, damage_taken, shots_fired, hits, headshots)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (character_id) DO UPDATE SET
+kills = kills + excluded.kills,
+npc_kills = npc_kills + excluded.npc_kills,
+deaths = deaths + excluded.deaths,
+damage_dealt = damage_dealt + excluded.damage_dealt,
+damage_taken = damage_taken + excluded.damage_taken,
+shots_fired = shots_fired + excluded.shots_fired,
+hits = hits + excluded.hits,
+headshots = headshots + excluded.headshots
+`
+
+type AddCharacterStatsParams struct {
+	CharacterID int64
+	Kills       int64
+	NpcKills    int64
+	Deaths      int64
+	DamageDealt int64
+	DamageTaken int64
+	ShotsFired  int64
+	Hits        int64
+	Headshots   int64
+}
+
+func (q *Queries) AddCharacterStats(ctx context.Context, arg AddCharacterStatsParams) error {
+	_, err := q.db.ExecContext(ctx, addCharacterStats,
+		arg.CharacterID,
+		arg.Kills,
+		arg.NpcKills,
+		arg.Deaths,
+		arg.DamageDealt,
+		arg.DamageTaken,
+		arg.ShotsFired,
+		arg.Hits,
+		arg.Headshots,
+	)
+	return err
+}
+
+const addCharacterWeaponStats = `-- name: AddCharacterWeaponStats :exec
+INSERT INTO character_weapon_stats (character_id, weapon_name, kills, damage_dealt, shots_fired, hits, headshots)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (character_id, weapon_name) DO UPDATE SET
+kills = kills + excluded.kills,
+damage_dealt = damage_dealt + excluded.damage_dealt,
+shots_fired = shots_fired + excluded.shots_fired,
+hits = hits + excluded.hits,
+headshots = headshots + excluded.headshots
+`
+
+type AddCharacterWeaponStatsParams struct {
+	CharacterID int64
+	WeaponName  string
+	Kills       int64
+	DamageDealt int64
+	ShotsFired  int64
+	Hits        int64
+	Headshots   int64
+}
+
+func (q *Queries) AddCharacterWeaponStats(ctx context.Context, arg AddCharacterWeaponStatsParams) error {
+	_, err := q.db.ExecContext(ctx, addCharacterWeaponStats,
+		arg.CharacterID,
+		arg.WeaponName,
+		arg.Kills,
+		arg.DamageDealt,
+		arg.ShotsFired,
+		arg.Hits,
+		arg.Headshots,
+	)
+	return err
+}
+
+const countFriends = `-- name: CountFriends :one
+SELECT COUNT(*) FROM friends WHERE character_id = ?
+`
+
+func (q *Queries) CountFriends(ctx context.Context, characterID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countFriends, characterID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createCharacter = `-- name: CreateCharacter :one
 INSERT INTO characters (user_id, gender, region_id, map_id, x, z, health, max_health, speed, rotation_y, is_crouching)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, user_id, gender, region_id, map_id, x, z, health, max_health, speed, rotation_y, weapon_slot, is_crouching
+RETURNING id, user_id, gender, region_id, map_id, x, z, health, max_health, speed, rotation_y, weapon_slot, is_crouching, level, experience
 `
 
 type CreateCharacterParams struct {
@@ -60,6 +146,80 @@ func (q *Queries) CreateCharacter(ctx context.Context, arg CreateCharacterParams
 		&i.RotationY,
 		&i.WeaponSlot,
 		&i.IsCrouching,
+		&i.Level,
+		&i.Experience,
+	)
+	return i, err
+}
+
+const createFriendRequest = `-- name: CreateFriendRequest :exec
+INSERT INTO friend_requests (sender_id, receiver_id) VALUES (?, ?)
+`
+
+type CreateFriendRequestParams struct {
+	SenderID   int64
+	ReceiverID int64
+}
+
+// Friend Operations
+func (q *Queries) CreateFriendRequest(ctx context.Context, arg CreateFriendRequestParams) error {
+	_, err := q.db.ExecContext(ctx, createFriendRequest, arg.SenderID, arg.ReceiverID)
+	return err
+}
+
+const createGuild = `-- name: CreateGuild :one
+INSERT INTO guilds (name, tag, leader_id) VALUES (?, ?, ?)
+RETURNING id, name, tag, leader_id
+`
+
+type CreateGuildParams struct {
+	Name     string
+	Tag      string
+	LeaderID int64
+}
+
+// Guild Operations
+func (q *Queries) CreateGuild(ctx context.Context, arg CreateGuildParams) (Guild, error) {
+	row := q.db.QueryRowContext(ctx, createGuild, arg.Name, arg.Tag, arg.LeaderID)
+	var i Guild
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Tag,
+		&i.LeaderID,
+	)
+	return i, err
+}
+
+const createMatch = `-- name: CreateMatch :one
+INSERT INTO matches (region_id, mode, started_at, ended_at, winner_team) VALUES (?, ?, ?, ?, ?)
+RETURNING id, region_id, mode, started_at, ended_at, winner_team
+`
+
+type CreateMatchParams struct {
+	RegionID   int64
+	Mode       string
+	StartedAt  int64
+	EndedAt    int64
+	WinnerTeam int64
+}
+
+func (q *Queries) CreateMatch(ctx context.Context, arg CreateMatchParams) (Match, error) {
+	row := q.db.QueryRowContext(ctx, createMatch,
+		arg.RegionID,
+		arg.Mode,
+		arg.StartedAt,
+		arg.EndedAt,
+		arg.WinnerTeam,
+	)
+	var i Match
+	err := row.Scan(
+		&i.ID,
+		&i.RegionID,
+		&i.Mode,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.WinnerTeam,
 	)
 	return i, err
 }
@@ -96,6 +256,109 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateU
 	return i, err
 }
 
+const deleteAccountRestriction = `-- name: DeleteAccountRestriction :exec
+DELETE FROM account_restrictions
+WHERE user_id = ? AND kind = ?
+`
+
+type DeleteAccountRestrictionParams struct {
+	UserID int64
+	Kind   string
+}
+
+func (q *Queries) DeleteAccountRestriction(ctx context.Context, arg DeleteAccountRestrictionParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAccountRestriction, arg.UserID, arg.Kind)
+	return err
+}
+
+const deleteArmorPieces = `-- name: DeleteArmorPieces :exec
+DELETE FROM character_armor WHERE character_id = ?
+`
+
+func (q *Queries) DeleteArmorPieces(ctx context.Context, characterID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteArmorPieces, characterID)
+	return err
+}
+
+const deleteFriend = `-- name: DeleteFriend :execrows
+DELETE FROM friends WHERE character_id = ? AND friend_id = ?
+`
+
+type DeleteFriendParams struct {
+	CharacterID int64
+	FriendID    int64
+}
+
+func (q *Queries) DeleteFriend(ctx context.Context, arg DeleteFriendParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteFriend, arg.CharacterID, arg.FriendID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteFriendRequest = `-- name: DeleteFriendRequest :execrows
+DELETE FROM friend_requests WHERE sender_id = ? AND receiver_id = ?
+`
+
+type DeleteFriendRequestParams struct {
+	SenderID   int64
+	ReceiverID int64
+}
+
+func (q *Queries) DeleteFriendRequest(ctx context.Context, arg DeleteFriendRequestParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteFriendRequest, arg.SenderID, arg.ReceiverID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteGuild = `-- name: DeleteGuild :exec
+DELETE FROM guilds WHERE id = ?
+`
+
+func (q *Queries) DeleteGuild(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteGuild, id)
+	return err
+}
+
+const deleteGuildMember = `-- name: DeleteGuildMember :exec
+DELETE FROM guild_members WHERE character_id = ?
+`
+
+func (q *Queries) DeleteGuildMember(ctx context.Context, characterID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteGuildMember, characterID)
+	return err
+}
+
+const deleteGuildMembers = `-- name: DeleteGuildMembers :exec
+DELETE FROM guild_members WHERE guild_id = ?
+`
+
+func (q *Queries) DeleteGuildMembers(ctx context.Context, guildID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteGuildMembers, guildID)
+	return err
+}
+
+const deleteGuildRanks = `-- name: DeleteGuildRanks :exec
+DELETE FROM guild_ranks WHERE guild_id = ?
+`
+
+func (q *Queries) DeleteGuildRanks(ctx context.Context, guildID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteGuildRanks, guildID)
+	return err
+}
+
+const deleteInventoryItems = `-- name: DeleteInventoryItems :exec
+DELETE FROM character_inventory WHERE character_id = ?
+`
+
+func (q *Queries) DeleteInventoryItems(ctx context.Context, characterID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteInventoryItems, characterID)
+	return err
+}
+
 const deleteWeaponSlots = `-- name: DeleteWeaponSlots :exec
 DELETE FROM character_weapons WHERE character_id = ?
 `
@@ -106,8 +369,35 @@ func (q *Queries) DeleteWeaponSlots(ctx context.Context, characterID int64) erro
 	return err
 }
 
+const getAccountRestriction = `-- name: GetAccountRestriction :one
+SELECT reason, expires_at
+FROM account_restrictions
+WHERE user_id = ? AND kind = ?
+LIMIT 1
+`
+
+type GetAccountRestrictionParams struct {
+	UserID int64
+	Kind   string
+}
+
+type GetAccountRestrictionRow struct {
+	Reason    string
+	ExpiresAt sql.NullInt64
+}
+
+func (q *Queries) GetAccountRestriction(ctx context.Context, arg GetAccountRestrictionParams) (GetAccountRestrictionRow, error) {
+	row := q.db.QueryRowContext(ctx, getAccountRestriction, arg.UserID, arg.Kind)
+	var i GetAccountRestrictionRow
+	err := row.Scan(
+		&i.Reason,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
 const getCharacterByID = `-- name: GetCharacterByID :one
-SELECT id, user_id, gender, region_id, map_id, x, z, health, max_health, speed, rotation_y, weapon_slot, is_crouching FROM characters WHERE id = ?
+SELECT id, user_id, gender, region_id, map_id, x, z, health, max_health, speed, rotation_y, weapon_slot, is_crouching, level, experience FROM characters WHERE id = ?
 `
 
 func (q *Queries) GetCharacterByID(ctx context.Context, id int64) (Character, error) {
@@ -127,12 +417,14 @@ func (q *Queries) GetCharacterByID(ctx context.Context, id int64) (Character, er
 		&i.RotationY,
 		&i.WeaponSlot,
 		&i.IsCrouching,
+		&i.Level,
+		&i.Experience,
 	)
 	return i, err
 }
 
 const getCharacterByUserID = `-- name: GetCharacterByUserID :one
-SELECT id, user_id, gender, region_id, map_id, x, z, health, max_health, speed, rotation_y, weapon_slot, is_crouching FROM characters WHERE user_id = ?
+SELECT id, user_id, gender, region_id, map_id, x, z, health, max_health, speed, rotation_y, weapon_slot, is_crouching, level, experience FROM characters WHERE user_id = ?
 `
 
 func (q *Queries) GetCharacterByUserID(ctx context.Context, userID int64) (Character, error) {
@@ -152,6 +444,8 @@ func (q *Queries) GetCharacterByUserID(ctx context.Context, userID int64) (Chara
 		&i.RotationY,
 		&i.WeaponSlot,
 		&i.IsCrouching,
+		&i.Level,
+		&i.Experience,
 	)
 	return i, err
 }
@@ -179,9 +473,106 @@ func (q *Queries) GetCharacterPosition(ctx context.Context, id int64) (GetCharac
 	return i, err
 }
 
+const getCharacterStats = `-- name: GetCharacterStats :one
+SELECT character_id, kills, npc_kills, deaths, damage_dealt, damage_taken, shots_fired, hits, headshots FROM character_stats WHERE character_id = ?
+`
+
+func (q *Queries) GetCharacterStats(ctx context.Context, characterID int64) (CharacterStat, error) {
+	row := q.db.QueryRowContext(ctx, getCharacterStats, characterID)
+	var i CharacterStat
+	err := row.Scan(
+		&i.CharacterID,
+		&i.Kills,
+		&i.NpcKills,
+		&i.Deaths,
+		&i.DamageDealt,
+		&i.DamageTaken,
+		&i.ShotsFired,
+		&i.Hits,
+		&i.Headshots,
+	)
+	return i, err
+}
+
+const getCharacterWeaponStats = `-- name: GetCharacterWeaponStats :many
+SELECT character_id, weapon_name, kills, damage_dealt, shots_fired, hits, headshots FROM character_weapon_stats WHERE character_id = ? ORDER BY kills DESC, weapon_name
+`
+
+func (q *Queries) GetCharacterWeaponStats(ctx context.Context, characterID int64) ([]CharacterWeaponStat, error) {
+	rows, err := q.db.QueryContext(ctx, getCharacterWeaponStats, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CharacterWeaponStat
+	for rows.Next() {
+		var i CharacterWeaponStat
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.WeaponName,
+			&i.Kills,
+			&i.DamageDealt,
+			&i.ShotsFired,
+			&i.Hits,
+			&i.Headshots,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFriends = `-- name: GetFriends :many
+SELECT f.friend_id, u.username, u.nickname
+FROM friends f
+JOIN characters c ON f.friend_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE f.character_id = ?
+`
+
+type GetFriendsRow struct {
+	FriendID int64
+	Username string
+	Nickname string
+}
+
+func (q *Queries) GetFriends(ctx context.Context, characterID int64) ([]GetFriendsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getFriends, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFriendsRow
+	for rows.Next() {
+		var i GetFriendsRow
+		if err := rows.Scan(
+			&i.FriendID,
+			&i.Username,
+			&i.Nickname,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getFullCharacterData = `-- name: GetFullCharacterData :one
 SELECT
-  c.id, c.gender, c.region_id, c.map_id, c.x, c.z, c.health, c.max_health, c.speed, c.rotation_y, c.weapon_slot, c.is_crouching,
+  c.id, c.gender, c.region_id, c.map_id, c.x, c.z, c.health, c.max_health, c.speed, c.rotation_y, c.weapon_slot, c.is_crouching, c.level, c.experience,
   u.username, u.nickname
 FROM characters c
 JOIN users u ON c.user_id = u.id
@@ -201,6 +592,8 @@ type GetFullCharacterDataRow struct {
 	RotationY   float64
 	WeaponSlot  int64
 	IsCrouching int64
+	Level       int64
+	Experience  int64
 	Username    string
 	Nickname    string
 }
@@ -221,88 +614,545 @@ func (q *Queries) GetFullCharacterData(ctx context.Context, id int64) (GetFullCh
 		&i.RotationY,
 		&i.WeaponSlot,
 		&i.IsCrouching,
+		&i.Level,
+		&i.Experience,
 		&i.Username,
 		&i.Nickname,
 	)
 	return i, err
 }
 
-const getUserByID = `-- name: GetUserByID :one
-SELECT id, username, nickname, password_hash, character_id FROM users WHERE id = ?
+const getGuildByID = `-- name: GetGuildByID :one
+SELECT id, name, tag, leader_id FROM guilds WHERE id = ?
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByID, id)
-	var i User
+func (q *Queries) GetGuildByID(ctx context.Context, id int64) (Guild, error) {
+	row := q.db.QueryRowContext(ctx, getGuildByID, id)
+	var i Guild
 	err := row.Scan(
 		&i.ID,
-		&i.Username,
-		&i.Nickname,
-		&i.PasswordHash,
-		&i.CharacterID,
+		&i.Name,
+		&i.Tag,
+		&i.LeaderID,
 	)
 	return i, err
 }
 
-const getUserByNickname = `-- name: GetUserByNickname :one
-SELECT id, username, nickname, password_hash, character_id
-FROM users
-WHERE nickname = ?
-LIMIT 1
+const getGuildMembers = `-- name: GetGuildMembers :many
+SELECT gm.character_id, gm.rank_index, u.nickname
+FROM guild_members gm
+JOIN characters c ON gm.character_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE gm.guild_id = ?
 `
 
-func (q *Queries) GetUserByNickname(ctx context.Context, nickname string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByNickname, nickname)
-	var i User
-	err := row.Scan(
-		&i.ID,
-		&i.Username,
-		&i.Nickname,
-		&i.PasswordHash,
-		&i.CharacterID,
-	)
-	return i, err
+type GetGuildMembersRow struct {
+	CharacterID int64
+	RankIndex   int64
+	Nickname    string
 }
 
-const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, nickname, password_hash, character_id
-FROM users
-WHERE username = ? COLLATE NOCASE
-LIMIT 1
+func (q *Queries) GetGuildMembers(ctx context.Context, guildID int64) ([]GetGuildMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGuildMembers, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGuildMembersRow
+	for rows.Next() {
+		var i GetGuildMembersRow
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.RankIndex,
+			&i.Nickname,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuildMembership = `-- name: GetGuildMembership :one
+SELECT guild_id, rank_index FROM guild_members WHERE character_id = ?
 `
 
-func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByUsername, username)
-	var i User
+type GetGuildMembershipRow struct {
+	GuildID   int64
+	RankIndex int64
+}
+
+func (q *Queries) GetGuildMembership(ctx context.Context, characterID int64) (GetGuildMembershipRow, error) {
+	row := q.db.QueryRowContext(ctx, getGuildMembership, characterID)
+	var i GetGuildMembershipRow
 	err := row.Scan(
-		&i.ID,
-		&i.Username,
-		&i.Nickname,
-		&i.PasswordHash,
-		&i.CharacterID,
+		&i.GuildID,
+		&i.RankIndex,
 	)
 	return i, err
 }
 
-const insertWeaponSlot = `-- name: InsertWeaponSlot :exec
-INSERT INTO character_weapons
-  (character_id, slot_index, weapon_name, weapon_type, display_name, ammo, reserve_ammo, fire_mode)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+const getGuildRanks = `-- name: GetGuildRanks :many
+SELECT rank_index, name, permissions FROM guild_ranks WHERE guild_id = ? ORDER BY rank_index
 `
 
-type InsertWeaponSlotParams struct {
-	CharacterID int64
-	SlotIndex   int64
-	WeaponName  string
-	WeaponType  string
-	DisplayName string
-	Ammo        int64
-	ReserveAmmo int64
-	FireMode    int64
+type GetGuildRanksRow struct {
+	RankIndex   int64
+	Name        string
+	Permissions int64
 }
 
-func (q *Queries) InsertWeaponSlot(ctx context.Context, arg InsertWeaponSlotParams) error {
-	_, err := q.db.ExecContext(ctx, insertWeaponSlot,
+func (q *Queries) GetGuildRanks(ctx context.Context, guildID int64) ([]GetGuildRanksRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGuildRanks, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGuildRanksRow
+	for rows.Next() {
+		var i GetGuildRanksRow
+		if err := rows.Scan(
+			&i.RankIndex,
+			&i.Name,
+			&i.Permissions,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIncomingFriendRequests = `-- name: GetIncomingFriendRequests :many
+SELECT fr.sender_id, u.username, u.nickname
+FROM friend_requests fr
+JOIN characters c ON fr.sender_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE fr.receiver_id = ?
+`
+
+type GetIncomingFriendRequestsRow struct {
+	SenderID int64
+	Username string
+	Nickname string
+}
+
+func (q *Queries) GetIncomingFriendRequests(ctx context.Context, receiverID int64) ([]GetIncomingFriendRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getIncomingFriendRequests, receiverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetIncomingFriendRequestsRow
+	for rows.Next() {
+		var i GetIncomingFriendRequestsRow
+		if err := rows.Scan(
+			&i.SenderID,
+			&i.Username,
+			&i.Nickname,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopKillDeathRatio = `-- name: GetTopKillDeathRatio :many
+SELECT s.character_id, u.nickname, s.kills, s.deaths
+FROM character_stats s
+JOIN characters c ON s.character_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE s.kills >= ?
+ORDER BY CAST(s.kills AS REAL) / MAX(s.deaths, 1) DESC, s.kills DESC
+LIMIT ?
+`
+
+type GetTopKillDeathRatioParams struct {
+	Kills int64
+	Limit int64
+}
+
+type GetTopKillDeathRatioRow struct {
+	CharacterID int64
+	Nickname    string
+	Kills       int64
+	Deaths      int64
+}
+
+func (q *Queries) GetTopKillDeathRatio(ctx context.Context, arg GetTopKillDeathRatioParams) ([]GetTopKillDeathRatioRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopKillDeathRatio, arg.Kills, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopKillDeathRatioRow
+	for rows.Next() {
+		var i GetTopKillDeathRatioRow
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.Nickname,
+			&i.Kills,
+			&i.Deaths,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopKills = `-- name: GetTopKills :many
+SELECT s.character_id, u.nickname, s.kills, s.deaths
+FROM character_stats s
+JOIN characters c ON s.character_id = c.id
+JOIN users u ON c.user_id = u.id
+WHERE s.kills > 0
+ORDER BY s.kills DESC, s.deaths ASC
+LIMIT ?
+`
+
+type GetTopKillsRow struct {
+	CharacterID int64
+	Nickname    string
+	Kills       int64
+	Deaths      int64
+}
+
+func (q *Queries) GetTopKills(ctx context.Context, limit int64) ([]GetTopKillsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopKills, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopKillsRow
+	for rows.Next() {
+		var i GetTopKillsRow
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.Nickname,
+			&i.Kills,
+			&i.Deaths,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopLevels = `-- name: GetTopLevels :many
+SELECT c.id AS character_id, u.nickname, c.level, c.experience
+FROM characters c
+JOIN users u ON c.user_id = u.id
+WHERE c.experience > 0
+ORDER BY c.level DESC, c.experience DESC
+LIMIT ?
+`
+
+type GetTopLevelsRow struct {
+	CharacterID int64
+	Nickname    string
+	Level       int64
+	Experience  int64
+}
+
+func (q *Queries) GetTopLevels(ctx context.Context, limit int64) ([]GetTopLevelsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopLevels, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopLevelsRow
+	for rows.Next() {
+		var i GetTopLevelsRow
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.Nickname,
+			&i.Level,
+			&i.Experience,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopMatchWins = `-- name: GetTopMatchWins :many
+SELECT mp.character_id, u.nickname, CAST(SUM(mp.won) AS INTEGER) AS wins, COUNT(*) AS played
+FROM match_players mp
+JOIN characters c ON mp.character_id = c.id
+JOIN users u ON c.user_id = u.id
+GROUP BY mp.character_id, u.nickname
+HAVING SUM(mp.won) > 0
+ORDER BY wins DESC, played ASC
+LIMIT ?
+`
+
+type GetTopMatchWinsRow struct {
+	CharacterID int64
+	Nickname    string
+	Wins        int64
+	Played      int64
+}
+
+func (q *Queries) GetTopMatchWins(ctx context.Context, limit int64) ([]GetTopMatchWinsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopMatchWins, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopMatchWinsRow
+	for rows.Next() {
+		var i GetTopMatchWinsRow
+		if err := rows.Scan(
+			&i.CharacterID,
+			&i.Nickname,
+			&i.Wins,
+			&i.Played,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, username, nickname, password_hash, character_id FROM users WHERE id = ?
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Nickname,
+		&i.PasswordHash,
+		&i.CharacterID,
+	)
+	return i, err
+}
+
+const getUserByNickname = `-- name: GetUserByNickname :one
+SELECT id, username, nickname, password_hash, character_id
+FROM users
+WHERE nickname = ?
+LIMIT 1
+`
+
+func (q *Queries) GetUserByNickname(ctx context.Context, nickname string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByNickname, nickname)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Nickname,
+		&i.PasswordHash,
+		&i.CharacterID,
+	)
+	return i, err
+}
+
+const getUserByUsername = `-- name: GetUserByUsername :one
+SELECT id, username, nickname, password_hash, character_id
+FROM users
+WHERE username = ? COLLATE NOCASE
+LIMIT 1
+`
+
+func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByUsername, username)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Nickname,
+		&i.PasswordHash,
+		&i.CharacterID,
+	)
+	return i, err
+}
+
+const hasFriendRequest = `-- name: HasFriendRequest :one
+SELECT COUNT(*) FROM friend_requests WHERE sender_id = ? AND receiver_id = ?
+`
+
+type HasFriendRequestParams struct {
+	SenderID   int64
+	ReceiverID int64
+}
+
+func (q *Queries) HasFriendRequest(ctx context.Context, arg HasFriendRequestParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, hasFriendRequest, arg.SenderID, arg.ReceiverID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const insertArmorPiece = `-- name: InsertArmorPiece :exec
+INSERT INTO character_armor (character_id, slot_index, item_name, wear) VALUES (?, ?, ?, ?)
+`
+
+type InsertArmorPieceParams struct {
+	CharacterID int64
+	SlotIndex   int64
+	ItemName    string
+	Wear        int64
+}
+
+func (q *Queries) InsertArmorPiece(ctx context.Context, arg InsertArmorPieceParams) error {
+	_, err := q.db.ExecContext(ctx, insertArmorPiece,
+		arg.CharacterID,
+		arg.SlotIndex,
+		arg.ItemName,
+		arg.Wear,
+	)
+	return err
+}
+
+const insertFriend = `-- name: InsertFriend :exec
+INSERT INTO friends (character_id, friend_id) VALUES (?, ?)
+`
+
+type InsertFriendParams struct {
+	CharacterID int64
+	FriendID    int64
+}
+
+func (q *Queries) InsertFriend(ctx context.Context, arg InsertFriendParams) error {
+	_, err := q.db.ExecContext(ctx, insertFriend, arg.CharacterID, arg.FriendID)
+	return err
+}
+
+const insertGuildMember = `-- name: InsertGuildMember :exec
+INSERT INTO guild_members (character_id, guild_id, rank_index) VALUES (?, ?, ?)
+`
+
+type InsertGuildMemberParams struct {
+	CharacterID int64
+	GuildID     int64
+	RankIndex   int64
+}
+
+func (q *Queries) InsertGuildMember(ctx context.Context, arg InsertGuildMemberParams) error {
+	_, err := q.db.ExecContext(ctx, insertGuildMember, arg.CharacterID, arg.GuildID, arg.RankIndex)
+	return err
+}
+
+const insertInventoryItem = `-- name: InsertInventoryItem :exec
+INSERT INTO character_inventory (character_id, slot_index, item_name, quantity, wear, ammo) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type InsertInventoryItemParams struct {
+	CharacterID int64
+	SlotIndex   int64
+	ItemName    string
+	Quantity    int64
+	Wear        int64
+	Ammo        int64
+}
+
+func (q *Queries) InsertInventoryItem(ctx context.Context, arg InsertInventoryItemParams) error {
+	_, err := q.db.ExecContext(ctx, insertInventoryItem,
+		arg.CharacterID,
+		arg.SlotIndex,
+		arg.ItemName,
+		arg.Quantity,
+		arg.Wear,
+		arg.Ammo,
+	)
+	return err
+}
+
+const insertMatchPlayer = `-- name: InsertMatchPlayer :exec
+INSERT INTO match_players (match_id, character_id, team, kills, deaths, won) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type InsertMatchPlayerParams struct {
+	MatchID     int64
+	CharacterID int64
+	Team        int64
+	Kills       int64
+	Deaths      int64
+	Won         int64
+}
+
+func (q *Queries) InsertMatchPlayer(ctx context.Context, arg InsertMatchPlayerParams) error {
+	_, err := q.db.ExecContext(ctx, insertMatchPlayer,
+		arg.MatchID,
+		arg.CharacterID,
+		arg.Team,
+		arg.Kills,
+		arg.Deaths,
+		arg.Won,
+	)
+	return err
+}
+
+const insertWeaponSlot = `-- name: InsertWeaponSlot :exec
+INSERT INTO character_weapons
+  (character_id, slot_index, weapon_name, weapon_type, display_name, ammo, reserve_ammo, fire_mode)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type InsertWeaponSlotParams struct {
+	CharacterID int64
+	SlotIndex   int64
+	WeaponName  string
+	WeaponType  string
+	DisplayName string
+	Ammo        int64
+	ReserveAmmo int64
+	FireMode    int64
+}
+
+func (q *Queries) InsertWeaponSlot(ctx context.Context, arg InsertWeaponSlotParams) error {
+	_, err := q.db.ExecContext(ctx, insertWeaponSlot,
 		arg.CharacterID,
 		arg.SlotIndex,
 		arg.WeaponName,
@@ -315,6 +1165,100 @@ func (q *Queries) InsertWeaponSlot(ctx context.Context, arg InsertWeaponSlotPara
 	return err
 }
 
+const isFriend = `-- name: IsFriend :one
+SELECT COUNT(*) FROM friends WHERE character_id = ? AND friend_id = ?
+`
+
+type IsFriendParams struct {
+	CharacterID int64
+	FriendID    int64
+}
+
+func (q *Queries) IsFriend(ctx context.Context, arg IsFriendParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, isFriend, arg.CharacterID, arg.FriendID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const loadArmorPieces = `-- name: LoadArmorPieces :many
+SELECT slot_index, item_name, wear FROM character_armor WHERE character_id = ?
+`
+
+type LoadArmorPiecesRow struct {
+	SlotIndex int64
+	ItemName  string
+	Wear      int64
+}
+
+func (q *Queries) LoadArmorPieces(ctx context.Context, characterID int64) ([]LoadArmorPiecesRow, error) {
+	rows, err := q.db.QueryContext(ctx, loadArmorPieces, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LoadArmorPiecesRow
+	for rows.Next() {
+		var i LoadArmorPiecesRow
+		if err := rows.Scan(
+			&i.SlotIndex,
+			&i.ItemName,
+			&i.Wear,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const loadInventoryItems = `-- name: LoadInventoryItems :many
+SELECT slot_index, item_name, quantity, wear, ammo FROM character_inventory WHERE character_id = ? ORDER BY slot_index
+`
+
+type LoadInventoryItemsRow struct {
+	SlotIndex int64
+	ItemName  string
+	Quantity  int64
+	Wear      int64
+	Ammo      int64
+}
+
+func (q *Queries) LoadInventoryItems(ctx context.Context, characterID int64) ([]LoadInventoryItemsRow, error) {
+	rows, err := q.db.QueryContext(ctx, loadInventoryItems, characterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LoadInventoryItemsRow
+	for rows.Next() {
+		var i LoadInventoryItemsRow
+		if err := rows.Scan(
+			&i.SlotIndex,
+			&i.ItemName,
+			&i.Quantity,
+			&i.Wear,
+			&i.Ammo,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const loadWeaponSlots = `-- name: LoadWeaponSlots :many
 SELECT slot_index, weapon_name, weapon_type, display_name, ammo, reserve_ammo, fire_mode
 FROM character_weapons
@@ -362,6 +1306,34 @@ func (q *Queries) LoadWeaponSlots(ctx context.Context, characterID int64) ([]Loa
 	return items, nil
 }
 
+const setAccountRestriction = `-- name: SetAccountRestriction :exec
+INSERT INTO account_restrictions (user_id, kind, reason, expires_at, created_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(user_id, kind) DO UPDATE SET
+  reason = excluded.reason,
+  expires_at = excluded.expires_at,
+  created_at = excluded.created_at
+`
+
+type SetAccountRestrictionParams struct {
+	UserID    int64
+	Kind      string
+	Reason    string
+	ExpiresAt sql.NullInt64
+	CreatedAt int64
+}
+
+func (q *Queries) SetAccountRestriction(ctx context.Context, arg SetAccountRestrictionParams) error {
+	_, err := q.db.ExecContext(ctx, setAccountRestriction,
+		arg.UserID,
+		arg.Kind,
+		arg.Reason,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const setUserCharacterID = `-- name: SetUserCharacterID :exec
 UPDATE users SET character_id = ? WHERE id = ?
 `
@@ -396,7 +1368,7 @@ func (q *Queries) UpdateCharacterStats(ctx context.Context, arg UpdateCharacterS
 const updateFullCharacterData = `-- name: UpdateFullCharacterData :exec
 UPDATE characters
 SET
-  region_id = ?, map_id = ?, x = ?, z = ?, health = ?, max_health = ?, speed = ?, rotation_y = ?, weapon_slot = ?, is_crouching = ?
+  region_id = ?, map_id = ?, x = ?, z = ?, health = ?, max_health = ?, speed = ?, rotation_y = ?, weapon_slot = ?, is_crouching = ?, level = ?, experience = ?
 WHERE id = ?
 `
 
@@ -411,6 +1383,8 @@ type UpdateFullCharacterDataParams struct {
 	RotationY   float64
 	WeaponSlot  int64
 	IsCrouching int64
+	Level       int64
+	Experience  int64
 	ID          int64
 }
 
@@ -426,7 +1400,61 @@ func (q *Queries) UpdateFullCharacterData(ctx context.Context, arg UpdateFullCha
 		arg.RotationY,
 		arg.WeaponSlot,
 		arg.IsCrouching,
+		arg.Level,
+		arg.Experience,
 		arg.ID,
 	)
 	return err
 }
+
+const updateGuildLeader = `-- name: UpdateGuildLeader :exec
+UPDATE guilds SET leader_id = ? WHERE id = ?
+`
+
+type UpdateGuildLeaderParams struct {
+	LeaderID int64
+	ID       int64
+}
+
+func (q *Queries) UpdateGuildLeader(ctx context.Context, arg UpdateGuildLeaderParams) error {
+	_, err := q.db.ExecContext(ctx, updateGuildLeader, arg.LeaderID, arg.ID)
+	return err
+}
+
+const updateGuildMemberRank = `-- name: UpdateGuildMemberRank :exec
+UPDATE guild_members SET rank_index = ? WHERE character_id = ?
+`
+
+type UpdateGuildMemberRankParams struct {
+	RankIndex   int64
+	CharacterID int64
+}
+
+func (q *Queries) UpdateGuildMemberRank(ctx context.Context, arg UpdateGuildMemberRankParams) error {
+	_, err := q.db.ExecContext(ctx, updateGuildMemberRank, arg.RankIndex, arg.CharacterID)
+	return err
+}
+
+const upsertGuildRank = `-- name: UpsertGuildRank :exec
+INSERT INTO guild_ranks (guild_id, rank_index, name, permissions) VALUES (?, ?, ?, ?)
+ON CONFLICT (guild_id, rank_index) DO UPDATE SET
+name = excluded.name,
+permissions = excluded.permissions
+`
+
+type UpsertGuildRankParams struct {
+	GuildID     int64
+	RankIndex   int64
+	Name        string
+	Permissions int64
+}
+
+func (q *Queries) UpsertGuildRank(ctx context.Context, arg UpsertGuildRankParams) error {
+	_, err := q.db.ExecContext(ctx, upsertGuildRank,
+		arg.GuildID,
+		arg.RankIndex,
+		arg.Name,
+		arg.Permissions,
+	)
+	return err
+}
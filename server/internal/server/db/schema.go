@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	_ "embed" // allows the use of the go:embed directive
+	"fmt"
+)
+
+// Embed the database schema to be used when creating the database tables
+// the go:embed directive tells the compiler to include the contents of the
+// schema.sql file in the binary when it is built, so we can access it at runtime.
+// It lives here so both the server and the test harness can create the tables.
+
+//go:embed config/schema.sql
+var Schema string
+
+// Column added to a table after the table was first released
+type columnMigration struct {
+	table      string
+	column     string
+	definition string // Must have a default, existing rows get it
+}
+
+// CREATE TABLE IF NOT EXISTS leaves tables from older databases alone, so columns added to a table
+// later are also listed here. The schema already has them, fresh databases skip every entry.
+var columnMigrations = []columnMigration{
+	{table: "character_inventory", column: "wear", definition: "INTEGER NOT NULL DEFAULT 0"},
+	{table: "characters", column: "level", definition: "INTEGER NOT NULL DEFAULT 1"},
+	{table: "characters", column: "experience", definition: "INTEGER NOT NULL DEFAULT 0"},
+	{table: "character_inventory", column: "ammo", definition: "INTEGER NOT NULL DEFAULT 0"},
+}
+
+// Creates the tables and adds the columns older databases are missing, safe to run on every start
+func Migrate(ctx context.Context, database *sql.DB) error {
+	if _, err := database.ExecContext(ctx, Schema); err != nil {
+		return fmt.Errorf("creating schema: %w", err)
+	}
+
+	for _, migration := range columnMigrations {
+		exists, err := hasColumn(ctx, database, migration.table, migration.column)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		// SQLite has no ADD COLUMN IF NOT EXISTS, that's why we look first
+		query := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", migration.table, migration.column, migration.definition)
+		if _, err := database.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("adding %s.%s: %w", migration.table, migration.column, err)
+		}
+	}
+	return nil
+}
+
+func hasColumn(ctx context.Context, database *sql.DB, table string, column string) (bool, error) {
+	rows, err := database.QueryContext(ctx, "SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return false, fmt.Errorf("reading columns of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return false, fmt.Errorf("reading columns of %s: %w", table, err)
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"server/internal/server/metrics"
+	"strings"
+	"time"
+)
+
+// Same as New() but every query is timed for the /metrics endpoint, pass a *sql.DB or a *sql.Tx
+func NewTimed(db DBTX) *Queries {
+	return New(&timedDBTX{db: db})
+}
+
+// Wraps the connection used by the generated queries and records how long each one takes
+type timedDBTX struct {
+	db DBTX
+}
+
+func (t *timedDBTX) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer metrics.DatabaseQuerySeconds.Since(time.Now(), queryName(query))
+	return t.db.ExecContext(ctx, query, args...)
+}
+
+func (t *timedDBTX) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return t.db.PrepareContext(ctx, query)
+}
+
+// Only measures until the first rows are ready, not the time spent iterating them
+func (t *timedDBTX) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer metrics.DatabaseQuerySeconds.Since(time.Now(), queryName(query))
+	return t.db.QueryContext(ctx, query, args...)
+}
+
+func (t *timedDBTX) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer metrics.DatabaseQuerySeconds.Since(time.Now(), queryName(query))
+	return t.db.QueryRowContext(ctx, query, args...)
+}
+
+// Generated queries start with "-- name: GetUserByUsername :one", we use that name as the label
+func queryName(query string) string {
+	name, found := strings.CutPrefix(query, "-- name: ")
+	if !found {
+		return "other"
+	}
+	name, _, _ = strings.Cut(name, " ")
+	return name
+}
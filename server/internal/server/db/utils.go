@@ -54,3 +54,55 @@ func BulkUpsertWeaponSlots(ctx context.Context, tx *sql.Tx, characterID int64, s
 	_, err := tx.ExecContext(ctx, query, args...)
 	return err
 }
+
+// Replaces every inventory row of this character, empty slots are not stored
+func ReplaceInventoryItems(ctx context.Context, tx *sql.Tx, characterID int64, slots []*objects.InventoryItem) error {
+	q := New(tx)
+
+	if err := q.DeleteInventoryItems(ctx, characterID); err != nil {
+		return err
+	}
+
+	for slotIndex, item := range slots {
+		if item == nil || item.Quantity == 0 {
+			continue
+		}
+		if err := q.InsertInventoryItem(ctx, InsertInventoryItemParams{
+			CharacterID: characterID,
+			SlotIndex:   int64(slotIndex),
+			ItemName:    item.ItemName,
+			Quantity:    int64(item.Quantity),
+			Wear:        int64(item.Wear),
+			Ammo:        int64(item.Ammo),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Replaces every equipped armor row of this character, empty slots are not stored
+func ReplaceArmorPieces(ctx context.Context, tx *sql.Tx, characterID int64, pieces []*objects.InventoryItem) error {
+	q := New(tx)
+
+	if err := q.DeleteArmorPieces(ctx, characterID); err != nil {
+		return err
+	}
+
+	for slotIndex, piece := range pieces {
+		if piece == nil {
+			continue
+		}
+		if err := q.InsertArmorPiece(ctx, InsertArmorPieceParams{
+			CharacterID: characterID,
+			SlotIndex:   int64(slotIndex),
+			ItemName:    piece.ItemName,
+			Wear:        int64(piece.Wear),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"time"
+)
+
+const maxFriends int = 50 // Max number of friends per character
+
+// Sends a friend request from this client to the character with that nickname
+// If the other character already sent us a request, we accept it instead
+func (h *Hub) SendFriendRequest(client Client, nickname string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	target, err := h.queries.GetUserByNickname(ctx, nickname)
+	if err != nil || !target.CharacterID.Valid {
+		return errors.New("character not found")
+	}
+
+	senderId := client.GetCharacterId()
+	receiverId := target.CharacterID.Int64
+
+	if senderId == receiverId {
+		return errors.New("you can't add yourself")
+	}
+
+	// Make sure we are not friends already
+	count, err := h.queries.IsFriend(ctx, db.IsFriendParams{CharacterID: senderId, FriendID: receiverId})
+	if err != nil {
+		return fmt.Errorf("is friend: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%s is already your friend", target.Nickname)
+	}
+
+	// If they already asked us, accepting is what both of us want
+	count, err = h.queries.HasFriendRequest(ctx, db.HasFriendRequestParams{SenderID: receiverId, ReceiverID: senderId})
+	if err != nil {
+		return fmt.Errorf("has friend request: %w", err)
+	}
+	if count > 0 {
+		return h.AcceptFriendRequest(client, target.Nickname)
+	}
+
+	// Only a hint for the sender, the limit is enforced for both characters when the request is accepted
+	friends, err := h.queries.CountFriends(ctx, senderId)
+	if err != nil {
+		return fmt.Errorf("count friends: %w", err)
+	}
+	if friends >= int64(maxFriends) {
+		return errors.New("friends list is full")
+	}
+
+	// The primary key prevents duplicated requests
+	err = h.queries.CreateFriendRequest(ctx, db.CreateFriendRequestParams{SenderID: senderId, ReceiverID: receiverId})
+	if err != nil {
+		return fmt.Errorf("request already sent to %s", target.Nickname)
+	}
+
+	// If the receiver is online, let them know right away
+	if receiver, online := h.GetClientByUsername(target.Username); online {
+		receiver.SendPacket(packets.NewFriendRequest(client.GetPlayerCharacter().Name))
+	}
+
+	return nil
+}
+
+// Accepts the pending friend request the character with that nickname sent to this client
+func (h *Hub) AcceptFriendRequest(client Client, nickname string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	sender, err := h.queries.GetUserByNickname(ctx, nickname)
+	if err != nil || !sender.CharacterID.Valid {
+		return errors.New("character not found")
+	}
+
+	senderId := sender.CharacterID.Int64
+	receiverId := client.GetCharacterId()
+
+	tx, err := h.Database.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	q := db.NewTimed(tx)
+
+	// Consume the pending request, if there was none, there is nothing to accept
+	rows, err := q.DeleteFriendRequest(ctx, db.DeleteFriendRequestParams{SenderID: senderId, ReceiverID: receiverId})
+	if err != nil {
+		return fmt.Errorf("delete friend request: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("no pending request from %s", sender.Nickname)
+	}
+
+	// Either list may have filled up since the request was sent, counted inside the transaction
+	// so two accepts can't both squeeze in the last slot
+	friends, err := q.CountFriends(ctx, receiverId)
+	if err != nil {
+		return fmt.Errorf("count friends: %w", err)
+	}
+	if friends >= int64(maxFriends) {
+		return errors.New("friends list is full")
+	}
+	friends, err = q.CountFriends(ctx, senderId)
+	if err != nil {
+		return fmt.Errorf("count friends: %w", err)
+	}
+	if friends >= int64(maxFriends) {
+		return fmt.Errorf("%s's friends list is full", sender.Nickname)
+	}
+
+	// Friendships are stored in both directions
+	if err := q.InsertFriend(ctx, db.InsertFriendParams{CharacterID: senderId, FriendID: receiverId}); err != nil {
+		return fmt.Errorf("insert friend: %w", err)
+	}
+	if err := q.InsertFriend(ctx, db.InsertFriendParams{CharacterID: receiverId, FriendID: senderId}); err != nil {
+		return fmt.Errorf("insert friend: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	// Refresh the list of both characters
+	h.SendFriendsList(client)
+	if senderClient, online := h.GetClientByUsername(sender.Username); online {
+		h.SendFriendsList(senderClient)
+	}
+
+	return nil
+}
+
+// Removes the friendship or any pending request between this client and that nickname
+func (h *Hub) RemoveFriend(client Client, nickname string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	other, err := h.queries.GetUserByNickname(ctx, nickname)
+	if err != nil || !other.CharacterID.Valid {
+		return errors.New("character not found")
+	}
+
+	characterId := client.GetCharacterId()
+	otherId := other.CharacterID.Int64
+
+	tx, err := h.Database.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	q := db.NewTimed(tx)
+
+	var removed int64 = 0
+	// Remove the friendship in both directions
+	for _, pair := range [][2]int64{{characterId, otherId}, {otherId, characterId}} {
+		rows, err := q.DeleteFriend(ctx, db.DeleteFriendParams{CharacterID: pair[0], FriendID: pair[1]})
+		if err != nil {
+			return fmt.Errorf("delete friend: %w", err)
+		}
+		removed += rows
+
+		// Also decline or cancel any pending request
+		rows, err = q.DeleteFriendRequest(ctx, db.DeleteFriendRequestParams{SenderID: pair[0], ReceiverID: pair[1]})
+		if err != nil {
+			return fmt.Errorf("delete friend request: %w", err)
+		}
+		removed += rows
+	}
+
+	if removed == 0 {
+		return fmt.Errorf("%s is not in your friends list", other.Nickname)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	// Refresh the list of both characters
+	h.SendFriendsList(client)
+	if otherClient, online := h.GetClientByUsername(other.Username); online {
+		h.SendFriendsList(otherClient)
+	}
+
+	return nil
+}
+
+// Sends this client a snapshot of every friend (with presence) and pending request
+func (h *Hub) SendFriendsList(client Client) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := h.queries.GetFriends(ctx, client.GetCharacterId())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return
+	}
+
+	friends := make([]*packets.FriendInfo, 0, len(rows))
+	for _, row := range rows {
+		friend := &packets.FriendInfo{Nickname: row.Nickname}
+
+		// If this friend is logged in, add where they are
+		if friendClient, online := h.GetClientByUsername(row.Username); online {
+			if player := friendClient.GetPlayerCharacter(); player != nil {
+				friend.IsOnline = true
+				friend.RegionId = player.GetRegionId()
+			}
+		}
+
+		friends = append(friends, friend)
+	}
+
+	requests, err := h.queries.GetIncomingFriendRequests(ctx, client.GetCharacterId())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return
+	}
+
+	pending := make([]string, 0, len(requests))
+	for _, request := range requests {
+		pending = append(pending, request.Nickname)
+	}
+
+	client.SendPacket(packets.NewFriendsList(friends, pending))
+}
+
+// Tells every online friend of this client that it logged in, logged out or changed region
+func (h *Hub) NotifyFriends(client Client, isOnline bool) {
+	player := client.GetPlayerCharacter()
+	if player == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := h.queries.GetFriends(ctx, client.GetCharacterId())
+	if err != nil {
+		return
+	}
+
+	// Offline friends don't have a region
+	var regionId uint64 = 0
+	if isOnline {
+		regionId = player.GetRegionId()
+	}
+	statusPacket := packets.NewFriendStatus(player.Name, isOnline, regionId)
+
+	for _, row := range rows {
+		if friendClient, online := h.GetClientByUsername(row.Username); online {
+			friendClient.SendPacket(statusPacket)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"server/internal/server/objects"
+	"server/internal/server/pathfinding"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Game data files, all optional, read from the data folder (see data_folder in the config)
+// Whatever has no file keeps what the server was built with, or what was loaded before
+const (
+	weaponsFile    string = "weapons.json"    // {"akm_rifle": {"min_damage": 12, ...}, ...}
+	respawnersFile string = "respawners.json" // {"1": [{"x": 0, "z": 0, "rotation": 2.35}, ...], ...} keyed by region ID
+	chatFilterFile string = "chat_filter.txt" // One word or phrase per line, # starts a comment
+)
+
+// Returned by ReloadGameData when the server runs without a data folder
+var ErrNoDataFolder = errors.New("no data folder configured")
+
+// Everything read from the data folder, only applied once all of it is valid
+type GameData struct {
+	Weapons    map[string]objects.WeaponStats // nil if there is no weapons file
+	Respawners map[uint64][]*Respawner        // nil if there is no respawners file
+	ChatFilter *ChatFilter                    // nil if there is no chat filter file
+	Files      []string                       // Files that were found
+}
+
+// Every problem found in the game data files, nothing was applied
+type GameDataError struct {
+	Problems []string
+}
+
+func (e *GameDataError) Error() string {
+	return fmt.Sprintf("invalid game data: %s", strings.Join(e.Problems, "; "))
+}
+
+// What a reload changed, sent back to the admin API
+type GameDataReport struct {
+	Files            []string `json:"files"`
+	Weapons          int      `json:"weapons"`           // Weapons now known to the server
+	RespawnerRegions int      `json:"respawner_regions"` // Regions whose respawners were replaced
+	ChatFilterWords  int      `json:"chat_filter_words"`
+}
+
+type respawnerJSON struct {
+	X        uint64  `json:"x"`
+	Z        uint64  `json:"z"`
+	Rotation float64 `json:"rotation"`
+}
+
+// Reads and checks every game data file in the folder, nothing here depends on the game state
+// so it's safe to call from any goroutine. The checks against the regions happen in checkGameData.
+// The data is returned even with problems, so the checks that need the hub can still list theirs
+func LoadGameData(folder string) (*GameData, []string) {
+	data := &GameData{}
+	problems := []string{}
+
+	// Missing files are fine, anything else we couldn't read is a problem
+	read := func(name string) []byte {
+		contents, err := os.ReadFile(filepath.Join(folder, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", name, err))
+			return nil
+		}
+		data.Files = append(data.Files, name)
+		return contents
+	}
+
+	// Weapons
+	if contents := read(weaponsFile); contents != nil {
+		weapons := make(map[string]objects.WeaponStats)
+		if err := decodeStrict(contents, &weapons); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", weaponsFile, err))
+		} else {
+			for _, name := range slices.Sorted(maps.Keys(weapons)) {
+				for _, problem := range weapons[name].Validate() {
+					problems = append(problems, fmt.Sprintf("%s: %s: %s", weaponsFile, name, problem))
+				}
+			}
+			data.Weapons = weapons
+		}
+	}
+
+	// Respawners
+	if contents := read(respawnersFile); contents != nil {
+		regions := make(map[string][]respawnerJSON)
+		if err := decodeStrict(contents, &regions); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", respawnersFile, err))
+		} else {
+			data.Respawners = make(map[uint64][]*Respawner)
+			for _, key := range slices.Sorted(maps.Keys(regions)) {
+				regionId, err := strconv.ParseUint(key, 10, 64)
+				if err != nil {
+					problems = append(problems, fmt.Sprintf("%s: %q is not a region ID", respawnersFile, key))
+					continue
+				}
+				if len(regions[key]) == 0 {
+					problems = append(problems, fmt.Sprintf("%s: region %d needs at least one respawner", respawnersFile, regionId))
+					continue
+				}
+				for _, respawner := range regions[key] {
+					data.Respawners[regionId] = append(data.Respawners[regionId], &Respawner{
+						Position: &pathfinding.Cell{X: respawner.X, Z: respawner.Z},
+						Rotation: respawner.Rotation,
+					})
+				}
+			}
+		}
+	}
+
+	// Chat filter
+	if contents := read(chatFilterFile); contents != nil {
+		words := []string{}
+		scanner := bufio.NewScanner(bytes.NewReader(contents))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" && !strings.HasPrefix(line, "#") {
+				words = append(words, line)
+			}
+		}
+		filter, err := NewChatFilter(words)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", chatFilterFile, err))
+		}
+		data.ChatFilter = filter
+	}
+
+	return data, problems
+}
+
+// Reads the data folder and swaps everything in between two ticks, all or nothing
+// Call it from any goroutine except the hub's own (admin API, signal handler)
+func (h *Hub) ReloadGameData() (*GameDataReport, error) {
+	if h.Config.DataFolder == "" {
+		return nil, ErrNoDataFolder
+	}
+
+	// Reading and parsing the files doesn't need the hub, only the checks and the swap do
+	data, problems := LoadGameData(h.Config.DataFolder)
+
+	var report *GameDataReport
+	var reloadErr error
+	err := h.Do(func() {
+		report, reloadErr = h.swapGameData(data, problems)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, reloadErr
+}
+
+// Loads the data folder when the hub starts, before any client can join
+func (h *Hub) loadInitialGameData() {
+	if h.Config.DataFolder == "" {
+		return
+	}
+
+	report, err := h.swapGameData(LoadGameData(h.Config.DataFolder))
+	if err != nil {
+		h.logger.Error("Game data not loaded, using the built-in data", "folder", h.Config.DataFolder, "error", err)
+		return
+	}
+	h.logger.Info("Game data loaded", "folder", h.Config.DataFolder, "files", report.Files)
+}
+
+// Applies the data only if neither the files nor the checks against the game found a problem
+// Only call this from the hub goroutine
+func (h *Hub) swapGameData(data *GameData, problems []string) (*GameDataReport, error) {
+	problems = append(problems, h.checkGameData(data)...)
+	if len(problems) > 0 {
+		return nil, &GameDataError{Problems: problems}
+	}
+	return h.applyGameData(data), nil
+}
+
+// Checks the data against the running game (regions, weapons in use)
+func (h *Hub) checkGameData(data *GameData) []string {
+	problems := []string{}
+
+	// Items, NPCs and player inventories refer to weapons by name, they can't disappear
+	if data.Weapons != nil {
+		for _, name := range slices.Sorted(maps.Keys(objects.WeaponData)) {
+			if _, exists := data.Weapons[name]; !exists {
+				problems = append(problems, fmt.Sprintf("%s: %s is missing, weapons can't be removed while the server runs", weaponsFile, name))
+			}
+		}
+	}
+
+	// Respawners must be on a walkable cell of a region that exists
+	for _, regionId := range slices.Sorted(maps.Keys(data.Respawners)) {
+		region, exists := h.GetRegionById(regionId)
+		if !exists {
+			problems = append(problems, fmt.Sprintf("%s: region %d doesn't exist", respawnersFile, regionId))
+			continue
+		}
+		grid := region.GetGrid()
+		for _, respawner := range data.Respawners[regionId] {
+			x, z := respawner.Position.X, respawner.Position.Z
+			if x >= grid.GetMaxWidth() || z >= grid.GetMaxHeight() {
+				problems = append(problems, fmt.Sprintf("%s: region %d: (%d, %d) is outside the %dx%d grid", respawnersFile, regionId, x, z, grid.GetMaxWidth(), grid.GetMaxHeight()))
+			} else if !grid.IsCellReachable(grid.LocalToMap(x, z)) {
+				problems = append(problems, fmt.Sprintf("%s: region %d: (%d, %d) is blocked", respawnersFile, regionId, x, z))
+			}
+		}
+	}
+
+	return problems
+}
+
+// Swaps everything in, the data must have been checked
+func (h *Hub) applyGameData(data *GameData) *GameDataReport {
+	report := &GameDataReport{Files: data.Files}
+	if data.Weapons != nil {
+		objects.SetWeaponData(data.Weapons)
+	}
+	for regionId, respawners := range data.Respawners {
+		region, _ := h.GetRegionById(regionId)
+		region.Respawners = respawners
+		report.RespawnerRegions++
+
+		// Dead players are looking at the old respawners
+		region.Clients.ForEach(func(id uint64, client Client) {
+			if player := client.GetPlayerCharacter(); player != nil && !player.IsAlive() {
+				region.SendRespawnOptions(client)
+			}
+		})
+	}
+	if data.ChatFilter != nil {
+		h.chatFilter = data.ChatFilter
+	}
+	report.Weapons = len(objects.WeaponData)
+	report.ChatFilterWords = h.chatFilter.Len()
+
+	return report
+}
+
+// Unknown keys are most likely typos, we'd rather fail than ignore them
+func decodeStrict(contents []byte, target any) error {
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(target)
+}
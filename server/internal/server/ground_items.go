@@ -0,0 +1,210 @@
+package server
+
+import (
+	"errors"
+	"math/rand"
+	"server/internal/server/objects"
+	"server/internal/server/pathfinding"
+	"server/pkg/packets"
+	"time"
+)
+
+const groundItemLifetime time.Duration = 5 * time.Minute   // Time before an item on the ground disappears
+const lootOwnershipWindow time.Duration = 30 * time.Second // Time only the killer can loot a dead player's items
+const pickupRange uint64 = 1                               // Max distance in cells to pick up an item without walking onto it
+
+// Item that can be spawned by a region's loot table
+type LootEntry struct {
+	ItemName    string
+	MinQuantity uint64
+	MaxQuantity uint64
+	Weight      uint64 // Relative chance of this entry being picked
+}
+
+// Periodically places random items on the ground of a region
+type LootTable struct {
+	Entries  []*LootEntry
+	Spawns   []*pathfinding.Cell // Cells where loot can appear
+	Interval time.Duration       // Time between spawns
+	MaxItems int                 // Max items from this table on the ground at the same time
+}
+
+// Picks a random entry based on the weights and rolls its quantity
+func (table *LootTable) Roll() (string, uint64) {
+	var totalWeight uint64 = 0
+	for _, entry := range table.Entries {
+		totalWeight += entry.Weight
+	}
+	if totalWeight == 0 {
+		return "", 0
+	}
+
+	roll := rand.Uint64() % totalWeight
+	for _, entry := range table.Entries {
+		if roll < entry.Weight {
+			quantity := entry.MinQuantity
+			if entry.MaxQuantity > entry.MinQuantity {
+				quantity += rand.Uint64() % (entry.MaxQuantity - entry.MinQuantity + 1)
+			}
+			return entry.ItemName, quantity
+		}
+		roll -= entry.Weight
+	}
+	return "", 0
+}
+
+// Sends a packet originated by the server to every client in this region
+func (r *Region) SendToAll(payload packets.Payload) {
+	r.Clients.ForEach(func(id uint64, client Client) {
+		client.SendPacket(payload)
+	})
+}
+
+// Places a stack of items on a cell and tells everyone in the region about it
+// An owner of 0 lets anyone pick it up right away
+func (r *Region) DropItem(stack *objects.InventoryItem, cell *pathfinding.Cell, ownerCharacterId int64) *objects.GroundItem {
+	now := time.Now()
+	item := &objects.GroundItem{
+		ItemName:         stack.ItemName,
+		Quantity:         stack.Quantity,
+		Wear:             stack.Wear,
+		Ammo:             stack.Ammo,
+		Position:         cell,
+		OwnerCharacterId: ownerCharacterId,
+		OwnerUntil:       now.Add(lootOwnershipWindow),
+		DespawnAt:        now.Add(groundItemLifetime),
+	}
+	item.Id = r.GroundItems.Add(item)
+
+	r.SendToAll(packets.NewSpawnGroundItem(item))
+	return item
+}
+
+// Drops every item a dead player was carrying on the cell where they died
+func (r *Region) DropInventory(player *objects.Player, cell *pathfinding.Cell, killerCharacterId int64) {
+	inventory := player.GetInventory()
+	for slot := range inventory.GetCapacity() {
+		item, err := inventory.RemoveFromSlot(slot, 0)
+		if err != nil {
+			continue // Empty slot
+		}
+		r.DropItem(item, cell, killerCharacterId)
+	}
+}
+
+// Removes an item from the ground and tells everyone in the region
+func (r *Region) RemoveGroundItem(id uint64) {
+	r.GroundItems.Remove(id)
+	r.SendToAll(packets.NewDespawnGroundItem(id))
+}
+
+// Sends every item on the ground to a client that just joined this region
+func (r *Region) SendGroundItems(client Client) {
+	r.GroundItems.ForEach(func(id uint64, item *objects.GroundItem) {
+		client.SendPacket(packets.NewSpawnGroundItem(item))
+	})
+}
+
+// Moves as much of a ground item as fits into the client's inventory, if they are close enough
+func (r *Region) PickupGroundItem(client Client, id uint64) error {
+	item, exists := r.GroundItems.Get(id)
+	if !exists {
+		return errors.New("item not found")
+	}
+
+	player := client.GetPlayerCharacter()
+	if !player.IsAlive() {
+		return errors.New("dead players can't pick up items")
+	}
+
+	// Validate the distance (don't trust the client)
+	position := player.GetGridPosition()
+	distanceX := max(position.X, item.Position.X) - min(position.X, item.Position.X)
+	distanceZ := max(position.Z, item.Position.Z) - min(position.Z, item.Position.Z)
+	if distanceX > pickupRange || distanceZ > pickupRange {
+		return errors.New("item is too far")
+	}
+
+	if !item.CanBePickedUpBy(client.GetCharacterId(), time.Now()) {
+		return errors.New("item belongs to someone else")
+	}
+
+	inventory := player.GetInventory()
+	var leftover uint64 = 0
+	if item.Wear > 0 {
+		// Used items keep their wear, so they can't be merged into a stack
+		if !inventory.AddWornItem(item.ItemName, item.Wear) {
+			return errors.New("no room in inventory")
+		}
+	} else if item.Ammo > 0 {
+		// Same for weapons that still have bullets in them
+		if inventory.AddStoredWeapon(item.ItemName, item.Ammo) == nil {
+			return errors.New("no room in inventory")
+		}
+	} else {
+		leftover = inventory.AddItem(item.ItemName, item.Quantity)
+		if leftover == item.Quantity {
+			return errors.New("no room in inventory")
+		}
+	}
+
+	// Leave whatever didn't fit on the ground
+	if leftover > 0 {
+		item.Quantity = leftover
+		r.SendToAll(packets.NewSpawnGroundItem(item))
+	} else {
+		r.RemoveGroundItem(id)
+	}
+
+	client.SendPacket(packets.NewInventorySnapshot(inventory))
+	return nil
+}
+
+// Picks up everything the client can take from the cell they walked onto
+func (r *Region) PickupGroundItemsAt(client Client, cell *pathfinding.Cell) {
+	r.GroundItems.ForEach(func(id uint64, item *objects.GroundItem) {
+		if item.Position == cell {
+			// Items we can't take yet stay on the ground
+			r.PickupGroundItem(client, id)
+		}
+	})
+}
+
+// Despawns expired items and spawns new loot, called every tick by the hub
+func (r *Region) updateGroundItems(now time.Time) {
+	lootOnGround := 0
+	r.GroundItems.ForEach(func(id uint64, item *objects.GroundItem) {
+		if item.IsExpired(now) {
+			r.RemoveGroundItem(id)
+			return
+		}
+		if item.FromLootTable {
+			lootOnGround++
+		}
+	})
+
+	if r.LootTable == nil || len(r.LootTable.Spawns) == 0 || now.Before(r.nextLootSpawn) {
+		return
+	}
+	r.nextLootSpawn = now.Add(r.LootTable.Interval)
+
+	if lootOnGround >= r.LootTable.MaxItems {
+		return
+	}
+
+	itemName, quantity := r.LootTable.Roll()
+	if quantity == 0 {
+		return
+	}
+
+	// Pick a random spawn point that is inside the grid
+	spawn := r.LootTable.Spawns[rand.Intn(len(r.LootTable.Spawns))]
+	grid := r.GetGrid()
+	cell := grid.LocalToMap(spawn.X, spawn.Z)
+	if cell == nil || !grid.IsCellReachable(cell) {
+		return
+	}
+
+	item := r.DropItem(&objects.InventoryItem{ItemName: itemName, Quantity: quantity}, cell, 0)
+	item.FromLootTable = true
+}
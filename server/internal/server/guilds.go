@@ -0,0 +1,515 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+	"time"
+)
+
+// Loads the guild this client belongs to (if any) and saves it in the player character
+// Called once after login, before the character is spawned so the guild tag is visible
+func (h *Hub) LoadGuildMembership(client Client) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	membership, err := h.queries.GetGuildMembership(ctx, client.GetCharacterId())
+	if err != nil {
+		// Not being in a guild is not an error
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("get guild membership: %w", err)
+	}
+
+	guild, err := h.getGuild(ctx, uint64(membership.GuildID))
+	if err != nil {
+		return err
+	}
+
+	client.GetPlayerCharacter().SetGuild(guild.Id, guild.Tag)
+	return nil
+}
+
+// Returns the guild from the shared objects, loading it from the database the first time
+func (h *Hub) getGuild(ctx context.Context, guildId uint64) (*objects.Guild, error) {
+	if guild, found := h.SharedObjects.Guilds.Get(guildId); found {
+		return guild, nil
+	}
+
+	row, err := h.queries.GetGuildByID(ctx, int64(guildId))
+	if err != nil {
+		return nil, fmt.Errorf("get guild: %w", err)
+	}
+
+	ranks, err := h.queries.GetGuildRanks(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get guild ranks: %w", err)
+	}
+
+	members, err := h.queries.GetGuildMembers(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get guild members: %w", err)
+	}
+
+	guild := &objects.Guild{
+		Id:       uint64(row.ID),
+		Name:     row.Name,
+		Tag:      row.Tag,
+		LeaderId: row.LeaderID,
+		Ranks:    make([]*objects.GuildRank, 0, len(ranks)),
+		Members:  make(map[int64]*objects.GuildMember, len(members)),
+	}
+	for _, rank := range ranks {
+		guild.Ranks = append(guild.Ranks, &objects.GuildRank{Name: rank.Name, Permissions: uint64(rank.Permissions)})
+	}
+	for _, member := range members {
+		guild.Members[member.CharacterID] = &objects.GuildMember{Nickname: member.Nickname, Rank: uint64(member.RankIndex)}
+	}
+
+	h.SharedObjects.Guilds.Add(guild, guild.Id)
+	return guild, nil
+}
+
+// Returns the guild this client belongs to
+func (h *Hub) getClientGuild(client Client) (*objects.Guild, error) {
+	guildId := client.GetPlayerCharacter().GetGuildId()
+	if guildId == 0 {
+		return nil, errors.New("you are not in a guild")
+	}
+
+	guild, found := h.SharedObjects.Guilds.Get(guildId)
+	if !found {
+		return nil, errors.New("guild not loaded")
+	}
+	return guild, nil
+}
+
+// Returns every online client that belongs to this guild, keyed by character ID
+func (h *Hub) getOnlineGuildMembers(guildId uint64) map[int64]Client {
+	online := make(map[int64]Client)
+	h.SharedObjects.Players.ForEach(func(id uint64, player *objects.Player) {
+		if player.GetGuildId() != guildId {
+			return
+		}
+		if client, found := h.GetClient(id); found {
+			online[client.GetCharacterId()] = client
+		}
+	})
+	return online
+}
+
+// Sends the roster of this client's guild (or an empty one if it has no guild)
+func (h *Hub) SendGuildRoster(client Client) {
+	guild, err := h.getClientGuild(client)
+	if err != nil {
+		client.SendPacket(packets.NewGuildRoster(nil, nil))
+		return
+	}
+	online := h.getOnlineGuildMembers(guild.Id)
+	client.SendPacket(packets.NewGuildRoster(guild, func(characterId int64) bool {
+		_, found := online[characterId]
+		return found
+	}))
+}
+
+// Sends the updated roster to every online member of this guild
+func (h *Hub) refreshGuildRoster(guild *objects.Guild) {
+	online := h.getOnlineGuildMembers(guild.Id)
+	roster := packets.NewGuildRoster(guild, func(characterId int64) bool {
+		_, found := online[characterId]
+		return found
+	})
+	for _, member := range online {
+		member.SendPacket(roster)
+	}
+}
+
+// Updates the guild tag of this client and tells everyone in its region
+func (h *Hub) setClientGuild(client Client, guildId uint64, guildTag string) {
+	client.GetPlayerCharacter().SetGuild(guildId, guildTag)
+
+	updateGuildTagPacket := packets.NewUpdateGuildTag(guildTag)
+	client.SendPacket(updateGuildTagPacket)
+	client.Broadcast(updateGuildTagPacket)
+}
+
+// Creates a new guild with this client as its leader
+func (h *Hub) CreateGuild(client Client, name string, tag string) error {
+	player := client.GetPlayerCharacter()
+	if player.GetGuildId() != 0 {
+		return errors.New("you are already in a guild")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.Database.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	q := db.NewTimed(tx)
+
+	row, err := q.CreateGuild(ctx, db.CreateGuildParams{
+		Name:     name,
+		Tag:      tag,
+		LeaderID: client.GetCharacterId(),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE constraint") {
+			if strings.Contains(err.Error(), "name") {
+				return errors.New("guild name already in use")
+			}
+			return errors.New("guild tag already in use")
+		}
+		return fmt.Errorf("create guild: %w", err)
+	}
+
+	ranks := objects.DefaultGuildRanks()
+	for rankIndex, rank := range ranks {
+		if err := q.UpsertGuildRank(ctx, db.UpsertGuildRankParams{
+			GuildID:     row.ID,
+			RankIndex:   int64(rankIndex),
+			Name:        rank.Name,
+			Permissions: int64(rank.Permissions),
+		}); err != nil {
+			return fmt.Errorf("insert guild rank: %w", err)
+		}
+	}
+
+	if err := q.InsertGuildMember(ctx, db.InsertGuildMemberParams{
+		CharacterID: client.GetCharacterId(),
+		GuildID:     row.ID,
+		RankIndex:   int64(objects.GUILD_LEADER),
+	}); err != nil {
+		return fmt.Errorf("insert guild member: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	guild := &objects.Guild{
+		Id:       uint64(row.ID),
+		Name:     row.Name,
+		Tag:      row.Tag,
+		LeaderId: row.LeaderID,
+		Ranks:    ranks,
+		Members: map[int64]*objects.GuildMember{
+			client.GetCharacterId(): {Nickname: player.Name, Rank: objects.GUILD_LEADER},
+		},
+	}
+	h.SharedObjects.Guilds.Add(guild, guild.Id)
+
+	h.setClientGuild(client, guild.Id, guild.Tag)
+	h.SendGuildRoster(client)
+	return nil
+}
+
+// Deletes the guild of this client and removes every member from it
+func (h *Hub) DisbandGuild(client Client) error {
+	guild, err := h.getClientGuild(client)
+	if err != nil {
+		return err
+	}
+	if !guild.HasPermission(client.GetCharacterId(), objects.GUILD_PERMISSION_DISBAND) {
+		return errors.New("you don't have permission to disband the guild")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.Database.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	q := db.NewTimed(tx)
+
+	// Foreign keys are not enforced, so we remove the children ourselves
+	if err := q.DeleteGuildMembers(ctx, int64(guild.Id)); err != nil {
+		return fmt.Errorf("delete guild members: %w", err)
+	}
+	if err := q.DeleteGuildRanks(ctx, int64(guild.Id)); err != nil {
+		return fmt.Errorf("delete guild ranks: %w", err)
+	}
+	if err := q.DeleteGuild(ctx, int64(guild.Id)); err != nil {
+		return fmt.Errorf("delete guild: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	// Remove the tag from every online member
+	for _, member := range h.getOnlineGuildMembers(guild.Id) {
+		h.setClientGuild(member, 0, "")
+		member.SendPacket(packets.NewGuildRoster(nil, nil))
+	}
+
+	h.SharedObjects.Guilds.Remove(guild.Id)
+	return nil
+}
+
+// Invites an online character to this client's guild
+func (h *Hub) InviteToGuild(client Client, nickname string) error {
+	guild, err := h.getClientGuild(client)
+	if err != nil {
+		return err
+	}
+	if !guild.HasPermission(client.GetCharacterId(), objects.GUILD_PERMISSION_INVITE) {
+		return errors.New("you don't have permission to invite")
+	}
+	if uint64(len(guild.Members)) >= objects.MAX_GUILD_MEMBERS {
+		return errors.New("guild is full")
+	}
+
+	target, online := h.GetClientByNickname(nickname)
+	if !online {
+		return fmt.Errorf("%s is not online", nickname)
+	}
+	targetPlayer := target.GetPlayerCharacter()
+	if targetPlayer.GetGuildId() != 0 {
+		return fmt.Errorf("%s is already in a guild", targetPlayer.Name)
+	}
+
+	// Only the last invite is kept
+	targetPlayer.SetGuildInvite(guild.Id)
+	target.SendPacket(packets.NewGuildInvite(client.GetPlayerCharacter().Name, guild.Name))
+	return nil
+}
+
+// Answers the last guild invite this client received
+func (h *Hub) RespondGuildInvite(client Client, accept bool) error {
+	player := client.GetPlayerCharacter()
+	guildId := player.GetGuildInvite()
+	if guildId == 0 {
+		return errors.New("you have no pending invites")
+	}
+	player.SetGuildInvite(0)
+
+	if !accept {
+		return nil
+	}
+	if player.GetGuildId() != 0 {
+		return errors.New("you are already in a guild")
+	}
+
+	guild, found := h.SharedObjects.Guilds.Get(guildId)
+	if !found {
+		return errors.New("guild no longer exists")
+	}
+	if uint64(len(guild.Members)) >= objects.MAX_GUILD_MEMBERS {
+		return errors.New("guild is full")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rank := guild.GetLowestRank()
+	if err := h.queries.InsertGuildMember(ctx, db.InsertGuildMemberParams{
+		CharacterID: client.GetCharacterId(),
+		GuildID:     int64(guild.Id),
+		RankIndex:   int64(rank),
+	}); err != nil {
+		return fmt.Errorf("insert guild member: %w", err)
+	}
+
+	guild.Members[client.GetCharacterId()] = &objects.GuildMember{Nickname: player.Name, Rank: rank}
+
+	h.setClientGuild(client, guild.Id, guild.Tag)
+	h.refreshGuildRoster(guild)
+	return nil
+}
+
+// Removes this client from its guild, the leader has to disband it instead
+func (h *Hub) LeaveGuild(client Client) error {
+	guild, err := h.getClientGuild(client)
+	if err != nil {
+		return err
+	}
+	if guild.LeaderId == client.GetCharacterId() {
+		return errors.New("the leader can't leave, promote someone else or disband the guild")
+	}
+
+	if err := h.removeGuildMember(guild, client.GetCharacterId()); err != nil {
+		return err
+	}
+
+	h.setClientGuild(client, 0, "")
+	client.SendPacket(packets.NewGuildRoster(nil, nil))
+	h.refreshGuildRoster(guild)
+	return nil
+}
+
+// Kicks a member with a lower rank from this client's guild
+func (h *Hub) KickFromGuild(client Client, nickname string) error {
+	guild, err := h.getClientGuild(client)
+	if err != nil {
+		return err
+	}
+	if !guild.HasPermission(client.GetCharacterId(), objects.GUILD_PERMISSION_KICK) {
+		return errors.New("you don't have permission to kick")
+	}
+
+	targetId, found := guild.GetMemberIdByNickname(nickname)
+	if !found {
+		return fmt.Errorf("%s is not in your guild", nickname)
+	}
+	if !guild.Outranks(client.GetCharacterId(), targetId) {
+		return fmt.Errorf("you can't kick %s", nickname)
+	}
+
+	if err := h.removeGuildMember(guild, targetId); err != nil {
+		return err
+	}
+
+	// If the kicked member is online, remove the tag right away
+	if target, online := h.GetClientByNickname(nickname); online {
+		h.setClientGuild(target, 0, "")
+		target.SendPacket(packets.NewGuildRoster(nil, nil))
+	}
+
+	h.refreshGuildRoster(guild)
+	return nil
+}
+
+// Deletes a member from the database and from the guild in memory
+func (h *Hub) removeGuildMember(guild *objects.Guild, characterId int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := h.queries.DeleteGuildMember(ctx, characterId); err != nil {
+		return fmt.Errorf("delete guild member: %w", err)
+	}
+
+	delete(guild.Members, characterId)
+	return nil
+}
+
+// Changes the rank of a member, giving away rank 0 transfers the leadership
+func (h *Hub) SetGuildRank(client Client, nickname string, rank uint64) error {
+	guild, err := h.getClientGuild(client)
+	if err != nil {
+		return err
+	}
+	if !guild.HasPermission(client.GetCharacterId(), objects.GUILD_PERMISSION_PROMOTE) {
+		return errors.New("you don't have permission to change ranks")
+	}
+	if rank >= uint64(len(guild.Ranks)) {
+		return errors.New("invalid rank")
+	}
+
+	targetId, found := guild.GetMemberIdByNickname(nickname)
+	if !found {
+		return fmt.Errorf("%s is not in your guild", nickname)
+	}
+	if !guild.Outranks(client.GetCharacterId(), targetId) {
+		return fmt.Errorf("you can't change the rank of %s", nickname)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	self, _ := guild.GetMember(client.GetCharacterId())
+	transfer := rank == objects.GUILD_LEADER && self.Rank == objects.GUILD_LEADER
+
+	// Nobody can promote someone to their own rank, except the leader giving away the guild
+	if rank <= self.Rank && !transfer {
+		return errors.New("you can only assign ranks below your own")
+	}
+
+	tx, err := h.Database.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	q := db.NewTimed(tx)
+
+	if err := q.UpdateGuildMemberRank(ctx, db.UpdateGuildMemberRankParams{RankIndex: int64(rank), CharacterID: targetId}); err != nil {
+		return fmt.Errorf("update guild member rank: %w", err)
+	}
+
+	// The old leader becomes the highest rank below the leader
+	if transfer {
+		if err := q.UpdateGuildMemberRank(ctx, db.UpdateGuildMemberRankParams{RankIndex: int64(objects.GUILD_LEADER + 1), CharacterID: client.GetCharacterId()}); err != nil {
+			return fmt.Errorf("update guild member rank: %w", err)
+		}
+		if err := q.UpdateGuildLeader(ctx, db.UpdateGuildLeaderParams{LeaderID: targetId, ID: int64(guild.Id)}); err != nil {
+			return fmt.Errorf("update guild leader: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	guild.Members[targetId].Rank = rank
+	if transfer {
+		self.Rank = objects.GUILD_LEADER + 1
+		guild.LeaderId = targetId
+	}
+
+	h.refreshGuildRoster(guild)
+	return nil
+}
+
+// Renames a rank or changes its permissions, the leader rank can't be edited
+func (h *Hub) EditGuildRank(client Client, rank uint64, name string, permissions uint64) error {
+	guild, err := h.getClientGuild(client)
+	if err != nil {
+		return err
+	}
+	if !guild.HasPermission(client.GetCharacterId(), objects.GUILD_PERMISSION_EDIT_RANKS) {
+		return errors.New("you don't have permission to edit ranks")
+	}
+	if rank == objects.GUILD_LEADER || rank >= uint64(len(guild.Ranks)) {
+		return errors.New("invalid rank")
+	}
+
+	// Ignore unknown permission bits
+	permissions &= objects.GUILD_PERMISSION_ALL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := h.queries.UpsertGuildRank(ctx, db.UpsertGuildRankParams{
+		GuildID:     int64(guild.Id),
+		RankIndex:   int64(rank),
+		Name:        name,
+		Permissions: int64(permissions),
+	}); err != nil {
+		return fmt.Errorf("update guild rank: %w", err)
+	}
+
+	guild.Ranks[rank] = &objects.GuildRank{Name: name, Permissions: permissions}
+
+	h.refreshGuildRoster(guild)
+	return nil
+}
+
+// Sends a chat message to every online member of this client's guild
+func (h *Hub) SendGuildMessage(client Client, text string) error {
+	guild, err := h.getClientGuild(client)
+	if err != nil {
+		return err
+	}
+	if !guild.HasPermission(client.GetCharacterId(), objects.GUILD_PERMISSION_CHAT) {
+		return errors.New("you don't have permission to talk in the guild chat")
+	}
+
+	guildMessagePacket := packets.NewGuildMessage(client.GetPlayerCharacter().Name, h.FilterChat(text))
+	for _, member := range h.getOnlineGuildMembers(guild.Id) {
+		member.SendPacketAs(client.GetId(), guildMessagePacket)
+	}
+	return nil
+}
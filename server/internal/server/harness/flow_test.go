@@ -0,0 +1,216 @@
+package harness
+
+import (
+	"server/internal/server/info"
+	"server/pkg/packets"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testPassword = "correct-horse"
+
+func TestHandshake(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		wantMetrics bool
+	}{
+		{name: "matching version", version: info.Version, wantMetrics: true},
+		{name: "old version", version: "0.0.0.1", wantMetrics: false},
+		{name: "empty version", version: "", wantMetrics: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := Start(t)
+			client := h.Connect()
+
+			// The server always introduces itself first
+			handshake := Expect[*packets.Packet_Handshake](t, client)
+			if handshake.Handshake.Version != info.Version {
+				t.Fatalf("server version = %q, want %q", handshake.Handshake.Version, info.Version)
+			}
+
+			client.Send(packets.NewHandshake(test.version))
+
+			// Only clients that moved to the Authentication state get the server metrics
+			if test.wantMetrics {
+				metrics := Expect[*packets.Packet_ServerMetrics](t, client)
+				if metrics.ServerMetrics.PlayersOnline != 0 {
+					t.Fatalf("players online = %d, want 0", metrics.ServerMetrics.PlayersOnline)
+				}
+			} else {
+				ExpectNone[*packets.Packet_ServerMetrics](t, client, 200*time.Millisecond)
+			}
+		})
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	register := func(username, nickname, password string) packets.Payload {
+		return &packets.Packet_RegisterRequest{RegisterRequest: &packets.RegisterRequest{
+			Username: username, Nickname: nickname, Password: password, Gender: "female",
+		}}
+	}
+	login := func(username, password string) packets.Payload {
+		return &packets.Packet_LoginRequest{LoginRequest: &packets.LoginRequest{
+			Username: username, Password: password,
+		}}
+	}
+
+	tests := []struct {
+		name       string
+		existing   bool // Register "alice" before sending the request
+		request    packets.Payload
+		wantDenied string // Prefix of the denied reason, empty if the request should succeed
+		wantNick   string // Nickname in the LoginSuccess packet
+	}{
+		{name: "login unknown user", request: login("nobody", testPassword), wantDenied: "Invalid username or password"},
+		{name: "login wrong password", existing: true, request: login("alice", "wrong-password"), wantDenied: "Invalid username or password"},
+		{name: "login short password", request: login("alice", "short"), wantDenied: "Invalid password"},
+		{name: "login", existing: true, request: login("alice", testPassword), wantNick: "Alice"},
+		{name: "login is case insensitive", existing: true, request: login("ALICE", testPassword), wantNick: "Alice"},
+		{name: "register", request: register("bob", "bob", testPassword)},
+		{name: "register taken username", existing: true, request: register("alice", "someone", testPassword), wantDenied: "Username alice already in use"},
+		{name: "register taken nickname", existing: true, request: register("someone", "ALICE", testPassword), wantDenied: "Nickname Alice already in use"},
+		{name: "register short password", request: register("bob", "bob", "short"), wantDenied: "Invalid password"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := Start(t)
+			client := h.ConnectAndHandshake()
+
+			if test.existing {
+				h.Register(client, "alice", "alice", testPassword)
+			}
+
+			client.Send(test.request)
+
+			if test.wantDenied != "" {
+				denied := Expect[*packets.Packet_RequestDenied](t, client)
+				if !strings.HasPrefix(denied.RequestDenied.Reason, test.wantDenied) {
+					t.Fatalf("denied reason = %q, want prefix %q", denied.RequestDenied.Reason, test.wantDenied)
+				}
+				return
+			}
+
+			switch test.request.(type) {
+			case *packets.Packet_RegisterRequest:
+				Expect[*packets.Packet_RequestGranted](t, client)
+			case *packets.Packet_LoginRequest:
+				success := Expect[*packets.Packet_LoginSuccess](t, client)
+				if success.LoginSuccess.Nickname != test.wantNick {
+					t.Fatalf("nickname = %q, want %q", success.LoginSuccess.Nickname, test.wantNick)
+				}
+			}
+		})
+	}
+}
+
+func TestEnterGame(t *testing.T) {
+	h := Start(t)
+	client := h.ConnectAndHandshake()
+	h.Register(client, "alice", "alice", testPassword)
+	h.Login(client, "alice", testPassword)
+
+	// New characters start in the Prototype region
+	regionData := Expect[*packets.Packet_RegionData](t, client)
+	if regionData.RegionData.RegionId != 1 || regionData.RegionData.GridWidth != 20 || regionData.RegionData.GridHeight != 40 {
+		t.Fatalf("region data = %v, want region 1 (20x40)", regionData.RegionData)
+	}
+
+	// Our own character is the first one we spawn
+	spawn := Expect[*packets.Packet_SpawnCharacter](t, client)
+	if spawn.SpawnCharacter.Id != client.GetId() || spawn.SpawnCharacter.Name != "Alice" {
+		t.Fatalf("spawned %d %q, want %d %q", spawn.SpawnCharacter.Id, spawn.SpawnCharacter.Name, client.GetId(), "Alice")
+	}
+
+	// Heartbeats are only answered once we are in the Game state
+	client.Send(packets.NewHeartbeat())
+	WaitFor[*packets.Packet_Heartbeat](t, client)
+}
+
+func TestGameFlowBetweenClients(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, h *Harness)
+	}{
+		{
+			name: "account already connected",
+			run: func(t *testing.T, h *Harness) {
+				h.Join("alice", testPassword)
+
+				second := h.ConnectAndHandshake()
+				second.Send(&packets.Packet_LoginRequest{LoginRequest: &packets.LoginRequest{
+					Username: "alice", Password: testPassword,
+				}})
+				denied := Expect[*packets.Packet_RequestDenied](t, second)
+				if denied.RequestDenied.Reason != "Account already connected" {
+					t.Fatalf("denied reason = %q", denied.RequestDenied.Reason)
+				}
+			},
+		},
+		{
+			name: "players see each other spawn",
+			run: func(t *testing.T, h *Harness) {
+				alice := h.Join("alice", testPassword)
+				bob := h.Join("bob", testPassword)
+
+				// Each client spawns itself first, then the others
+				WaitFor[*packets.Packet_SpawnCharacter](t, alice)
+				if spawn := WaitFor[*packets.Packet_SpawnCharacter](t, alice); spawn.SpawnCharacter.Id != bob.GetId() {
+					t.Fatalf("alice spawned %d, want bob (%d)", spawn.SpawnCharacter.Id, bob.GetId())
+				}
+				WaitFor[*packets.Packet_SpawnCharacter](t, bob)
+				if spawn := WaitFor[*packets.Packet_SpawnCharacter](t, bob); spawn.SpawnCharacter.Id != alice.GetId() {
+					t.Fatalf("bob spawned %d, want alice (%d)", spawn.SpawnCharacter.Id, alice.GetId())
+				}
+			},
+		},
+		{
+			name: "public messages reach the region",
+			run: func(t *testing.T, h *Harness) {
+				alice := h.Join("alice", testPassword)
+				bob := h.Join("bob", testPassword)
+
+				// The server ignores the nickname we send and uses the one from memory
+				alice.Send(packets.NewPublicMessage("Mallory", "hello there"))
+
+				message := WaitFor[*packets.Packet_PublicMessage](t, bob)
+				if message.PublicMessage.Nickname != "Alice" || message.PublicMessage.Text != "hello there" {
+					t.Fatalf("bob got %v", message.PublicMessage)
+				}
+				ExpectNone[*packets.Packet_PublicMessage](t, alice, 200*time.Millisecond)
+			},
+		},
+		{
+			name: "disconnecting tells the region",
+			run: func(t *testing.T, h *Harness) {
+				alice := h.Join("alice", testPassword)
+				bob := h.Join("bob", testPassword)
+
+				alice.Disconnect()
+				<-alice.Done()
+
+				left := WaitFor[*packets.Packet_ClientLeft](t, bob)
+				if left.ClientLeft.Nickname != "Alice" {
+					t.Fatalf("client left = %q, want Alice", left.ClientLeft.Nickname)
+				}
+
+				// Alice can log back in once she is gone
+				again := h.ConnectAndHandshake()
+				if success := h.Login(again, "alice", testPassword); success.Nickname != "Alice" {
+					t.Fatalf("nickname = %q, want Alice", success.Nickname)
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.run(t, Start(t))
+		})
+	}
+}
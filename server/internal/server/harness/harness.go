@@ -0,0 +1,211 @@
+// Boots a real Hub against a throwaway in-memory database so tests can drive
+// clients through the Connected -> Authentication -> Game flow without a websocket
+package harness
+
+import (
+	"context"
+	"database/sql"
+	"server/internal/server"
+	"server/internal/server/clients"
+	"server/internal/server/config"
+	"server/internal/server/db"
+	"server/internal/server/info"
+	"server/pkg/packets"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	_ "modernc.org/sqlite" // registers itself with the sql package
+)
+
+// How long we wait for a packet before failing the test
+const DefaultTimeout = 3 * time.Second
+
+// Server defaults, except for the cheapest bcrypt cost so registering and logging in stay fast
+func Config() *config.Config {
+	settings := config.Default()
+	settings.BcryptCost = bcrypt.MinCost
+	return settings
+}
+
+// A running Hub plus everything needed to tear it down at the end of a test
+type Harness struct {
+	Hub      *server.Hub
+	Database *sql.DB
+	t        testing.TB
+	clients  []*clients.FakeClient
+}
+
+// Creates the database, the Hub and starts it, everything is cleaned up when the test ends
+func Start(t testing.TB) *Harness {
+	t.Helper()
+
+	// Every connection to :memory: is its own database, so the pool is kept to the one that has the schema.
+	// A query that escapes an open transaction blocks until its context times out, so tests catch it
+	database, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	database.SetMaxOpenConns(1)
+
+	// Create the tables from the same schema the server uses
+	_, err = database.ExecContext(context.Background(), db.Schema)
+	if err != nil {
+		database.Close()
+		t.Fatalf("creating schema: %v", err)
+	}
+
+	h := &Harness{
+		Hub:      server.CreateHub(database, Config()),
+		Database: database,
+		t:        t,
+	}
+
+	// NOTE: the hub loop never returns, it just stops getting work once the test is over
+	// The regions are created before the loop starts accepting clients, so Connect() can't race them
+	go h.Hub.Start()
+
+	t.Cleanup(h.stop)
+
+	return h
+}
+
+// Disconnects every client (saving their characters) and closes the database
+func (h *Harness) stop() {
+	for _, client := range h.clients {
+		client.Disconnect()
+	}
+	for _, client := range h.clients {
+		select {
+		case <-client.Done():
+		case <-time.After(DefaultTimeout):
+			h.t.Errorf("client %d did not disconnect in time", client.GetId())
+		}
+	}
+	h.Database.Close()
+}
+
+// Connects a new fake client, it will be in the Connected state waiting for our handshake
+func (h *Harness) Connect() *clients.FakeClient {
+	client := clients.NewFakeClient(h.Hub)
+	h.clients = append(h.clients, client)
+	h.Hub.Connect(client)
+	return client
+}
+
+// Connects a new fake client and completes the handshake, it will be in the Authentication state
+func (h *Harness) ConnectAndHandshake() *clients.FakeClient {
+	h.t.Helper()
+
+	client := h.Connect()
+	Expect[*packets.Packet_Handshake](h.t, client)
+	client.Send(packets.NewHandshake(info.Version))
+	Expect[*packets.Packet_ServerMetrics](h.t, client)
+	return client
+}
+
+// Registers a new account with a connected client, fails the test if the server denies it
+func (h *Harness) Register(client *clients.FakeClient, username, nickname, password string) {
+	h.t.Helper()
+
+	client.Send(&packets.Packet_RegisterRequest{RegisterRequest: &packets.RegisterRequest{
+		Username: username,
+		Nickname: nickname,
+		Password: password,
+		Gender:   "male",
+	}})
+	Expect[*packets.Packet_RequestGranted](h.t, client)
+}
+
+// Logs in with a connected client, fails the test if the server denies it
+func (h *Harness) Login(client *clients.FakeClient, username, password string) *packets.LoginSuccess {
+	h.t.Helper()
+
+	client.Send(&packets.Packet_LoginRequest{LoginRequest: &packets.LoginRequest{
+		Username: username,
+		Password: password,
+	}})
+	return Expect[*packets.Packet_LoginSuccess](h.t, client).LoginSuccess
+}
+
+// Shortcut for tests that just need somebody in the game: connect, register and login
+func (h *Harness) Join(username, password string) *clients.FakeClient {
+	h.t.Helper()
+
+	client := h.ConnectAndHandshake()
+	h.Register(client, username, username, password)
+	h.Login(client, username, password)
+	Expect[*packets.Packet_RegionData](h.t, client)
+	return client
+}
+
+// Returns the next packet the server sent to this client
+func Next(t testing.TB, client *clients.FakeClient) *packets.Packet {
+	t.Helper()
+
+	select {
+	case packet, ok := <-client.Received():
+		if !ok {
+			t.Fatalf("client %d was disconnected while waiting for a packet", client.GetId())
+		}
+		return packet
+	case <-time.After(DefaultTimeout):
+		t.Fatalf("client %d timed out waiting for a packet", client.GetId())
+	}
+	return nil
+}
+
+// The next packet must be of type T, anything else fails the test
+func Expect[T packets.Payload](t testing.TB, client *clients.FakeClient) T {
+	t.Helper()
+
+	packet := Next(t, client)
+	payload, ok := packet.Payload.(T)
+	if !ok {
+		var want T
+		t.Fatalf("client %d expected %T, got %T: %v", client.GetId(), want, packet.Payload, packet.Payload)
+	}
+	return payload
+}
+
+// Skips packets until one of type T arrives, useful in the Game state where snapshots keep coming
+func WaitFor[T packets.Payload](t testing.TB, client *clients.FakeClient) T {
+	t.Helper()
+
+	deadline := time.After(DefaultTimeout)
+	for {
+		select {
+		case packet, ok := <-client.Received():
+			if !ok {
+				var want T
+				t.Fatalf("client %d was disconnected while waiting for %T", client.GetId(), want)
+			}
+			if payload, ok := packet.Payload.(T); ok {
+				return payload
+			}
+		case <-deadline:
+			var want T
+			t.Fatalf("client %d timed out waiting for %T", client.GetId(), want)
+		}
+	}
+}
+
+// Fails the test if a packet of type T arrives within the given time
+func ExpectNone[T packets.Payload](t testing.TB, client *clients.FakeClient, wait time.Duration) {
+	t.Helper()
+
+	deadline := time.After(wait)
+	for {
+		select {
+		case packet, ok := <-client.Received():
+			if !ok {
+				return
+			}
+			if _, ok := packet.Payload.(T); ok {
+				t.Fatalf("client %d got an unexpected %T: %v", client.GetId(), packet.Payload, packet.Payload)
+			}
+		case <-deadline:
+			return
+		}
+	}
+}
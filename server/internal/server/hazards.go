@@ -0,0 +1,69 @@
+package server
+
+import (
+	"server/internal/server/pathfinding"
+	"server/pkg/packets"
+	"time"
+)
+
+// Returns every cell inside a rectangle, both corners included
+func CellRect(fromX, fromZ, toX, toZ uint64) []*pathfinding.Cell {
+	var cells []*pathfinding.Cell
+	for x := fromX; x <= toX; x++ {
+		for z := fromZ; z <= toZ; z++ {
+			cells = append(cells, &pathfinding.Cell{X: x, Z: z})
+		}
+	}
+	return cells
+}
+
+// Sends every hazard zone to a client that just joined this region
+func (r *Region) SendHazardZones(client Client) {
+	client.SendPacket(packets.NewHazardZones(r.HazardZones))
+}
+
+// Hurts every unprotected player standing in a hazard, called every tick by the hub
+func (r *Region) updateHazards(now time.Time) {
+	for _, zone := range r.HazardZones {
+		if !zone.IsDue(now) {
+			continue
+		}
+
+		r.Clients.ForEach(func(id uint64, client Client) {
+			player := client.GetPlayerCharacter()
+			if player == nil || !player.IsAlive() || !zone.Contains(player.GetGridPosition()) {
+				return
+			}
+
+			if player.IsProtectedFrom(zone.Type) {
+				return
+			}
+
+			if zone.Damage > 0 {
+				// Armor can absorb hazard damage (vests resist fire), it never counts as a headshot
+				damage, mitigated, armorSlot := player.MitigateDamage(zone.Damage, zone.DamageType, false)
+				player.DecreaseHealth(damage)
+				player.GetUnsavedStats().DamageTaken += damage
+
+				var armorPieces []*packets.ArmorPiece
+				if mitigated > 0 {
+					armorPieces = append(armorPieces, packets.NewArmorPiece(armorSlot, player.GetArmor(armorSlot)))
+				}
+
+				// Attacker 0 means the damage came from the environment
+				applyDamagePacket := packets.NewApplyPlayerDamage(0, id, damage, zone.DamageType, false, mitigated, armorPieces)
+				client.SendPacket(applyDamagePacket)
+				client.Broadcast(applyDamagePacket)
+
+				if !player.IsAlive() {
+					r.KillPlayer(client, 0, 0, zone.Name, false)
+					return
+				}
+			}
+
+			if zone.StatusEffect != "" {
+				r.ApplyStatusEffect(client, zone.StatusEffect)
+			}
+		})
+	}
+}
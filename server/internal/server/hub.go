@@ -4,10 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"server/internal/server/adt"
+	"server/internal/server/config"
 	"server/internal/server/db"
+	"server/internal/server/logging"
+	"server/internal/server/metrics"
 	"server/internal/server/objects"
 	"server/internal/server/pathfinding"
 	"server/pkg/packets"
@@ -15,8 +18,7 @@ import (
 	"time"
 )
 
-const serverTick int = 30 // Server process max 30 packets per second per player (30 Hz)
-const packetsPerClient int = 5
+const hubCommandTimeout time.Duration = 5 * time.Second // Time Do() waits for the hub to be free
 
 var createUserMutex sync.Mutex
 
@@ -42,13 +44,34 @@ type Hub struct {
 	// Map of every region
 	Regions *adt.MapMutex[*Region]
 
+	// Functions sent by other goroutines (admin API) to run on the hub goroutine between ticks
+	commandChannel chan func()
+
 	// Maps username to client ID
 	usernameToClient        map[string]uint64
 	usernameToClientRWMutex sync.RWMutex // Protects the usernameToClient map
 
+	// Settings loaded at startup (tick rate, buffer sizes, etc)
+	Config *config.Config
+
 	// Only the hub writes to the DB
 	Database *sql.DB
 	queries  *db.Queries
+
+	// Latest leaderboards, refreshed in the background
+	leaderboards LeaderboardCache
+
+	// Folder where client sessions are recorded, empty if recording is off
+	recordingsFolder string
+
+	// Words hidden from the public and guild chats, replaced by ReloadGameData()
+	chatFilter *ChatFilter
+
+	// When each account was last kicked for flooding, enough of them in a row is a temporary ban (see ratelimit.go)
+	floodKicks map[string][]time.Time
+
+	logger      *slog.Logger
+	adminLogger *slog.Logger
 }
 
 // We keep in SharedObjects a list of all the objects in the server
@@ -57,44 +80,60 @@ type Hub struct {
 type SharedObjects struct {
 	// The ID of the player is the ID of the ephemeral client connection
 	Players *adt.MapMutex[*objects.Player]
+
+	// Guilds with at least one member online since the server started, keyed by guild ID
+	Guilds *adt.MapMutex[*objects.Guild]
 }
 
 // Creates a new empty hub object, we have to pass a valid DB connection
-func CreateHub(database *sql.DB) *Hub {
+func CreateHub(database *sql.DB, config *config.Config) *Hub {
 	return &Hub{
+		Config: config,
 		// Collection of every connected client in the server
 		Clients:             adt.NewMapMutex[Client](),
 		AddClientChannel:    make(chan Client),
 		RemoveClientChannel: make(chan Client),
 		BroadcastChannel:    make(chan *packets.Packet),
+		commandChannel:      make(chan func()),
 		// Collection of every available region in the server
 		Regions: adt.NewMapMutex[*Region](),
 		// Username-to-client map for O(1) lookups
 		usernameToClient: make(map[string]uint64),
+		// Flooding kicks per username
+		floodKicks: make(map[string][]time.Time),
 		// Database connection
 		Database: database,
-		queries:  db.New(database),
+		queries:  db.NewTimed(database),
 		// Game objects
 		SharedObjects: &SharedObjects{
 			// Create an empty map of players
 			Players: adt.NewMapMutex[*objects.Player](),
+			// Create an empty map of guilds
+			Guilds: adt.NewMapMutex[*objects.Guild](),
 		},
+		logger:      logging.For(logging.Hub),
+		adminLogger: logging.For(logging.Admin),
 	}
 }
 
 // Creates a client for the new connection and begins the concurrent read and write pumps
 func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request) (Client, error), writer http.ResponseWriter, request *http.Request) {
 	// Because the connection goes through the onion protocol, the IP gets anonymized, we can only see the port
-	log.Println("New client connected from", request.RemoteAddr)
+	h.logger.Info("New client connected", "remote_addr", request.RemoteAddr)
 
 	// Executes the function that was passed as a parameter
 	client, err := getNewClient(h, writer, request)
 
 	if err != nil {
-		log.Printf("Error obtaining client for new connection: %v\n", err)
+		h.logger.Warn("Error obtaining client for new connection", "remote_addr", request.RemoteAddr, "error", err)
 		return
 	}
 
+	h.Connect(client)
+}
+
+// Adds a client to the Hub and starts its pumps, used for websockets and in-memory clients alike
+func (h *Hub) Connect(client Client) {
 	// Send this client to the add client channel
 	h.AddClientChannel <- client
 
@@ -106,21 +145,24 @@ func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request)
 
 // Listens for packets on each channel
 func (h *Hub) Start() {
-	log.Println("Starting hub...")
+	h.logger.Info("Starting hub...")
 
 	// CREATE AND INITIALIZE REGIONS
 	// Create a new region called Prototype with a grid of (X by Z) squares
 	h.CreateRegion("Prototype", "prototype", 20, 40, 1)
 	h.CreateRegion("Maze", "maze", 10, 10, 2)
 
-	// TO IMPLEMENT -> Adding static obstacles to the current map
-	// add obstacles [20, 33] = "stone_column", rotate it by 30°
+	// Weapons, respawners and the chat filter from the data folder replace the built-in ones
+	h.loadInitialGameData()
+
+	// Keep the leaderboards up to date without blocking the game loop
+	go h.refreshLeaderboardsLoop()
 
 	// Create a ticker that ticks every X seconds
-	ticker := time.NewTicker(time.Second / time.Duration(serverTick))
+	ticker := time.NewTicker(h.Config.TickInterval())
 	defer ticker.Stop()
 
-	log.Println("Hub created, awaiting clients...")
+	h.logger.Info("Hub created, awaiting clients...")
 
 	// Infinite for loop
 	for {
@@ -150,13 +192,18 @@ func (h *Hub) Start() {
 				}
 			})
 
+		// Run commands from other goroutines, the game state can't change under them
+		case command := <-h.commandChannel:
+			command()
+
 		// Process packets from the client's processing channel
 		case <-ticker.C:
+			tickStart := time.Now()
 			h.Clients.ForEach(func(id uint64, client Client) {
 
 			ClientLoop: // Label for the outer loop to break out of it
 				// Process up to max packets per client per tick to prevent starvation
-				for i := 0; i < packetsPerClient; i++ {
+				for i := 0; i < h.Config.PacketsPerClient; i++ {
 					select {
 					case packet := <-client.GetProcessingChannel():
 						client.ProcessPacket(packet.SenderId, packet.Payload)
@@ -166,10 +213,34 @@ func (h *Hub) Start() {
 					}
 				}
 			})
+
+			// Update the timed systems of every region (ground items, etc)
+			now := time.Now()
+			h.Regions.ForEach(func(id uint64, region *Region) {
+				region.Update(now)
+			})
+
+			metrics.HubTickSeconds.Since(tickStart)
 		}
 	}
 }
 
+// Runs a function on the hub goroutine between ticks and waits until it's done
+// Never call this from the hub goroutine itself (packet handlers, region updates), it would deadlock
+func (h *Hub) Do(command func()) error {
+	done := make(chan struct{})
+	select {
+	case h.commandChannel <- func() {
+		defer close(done)
+		command()
+	}:
+	case <-time.After(hubCommandTimeout):
+		return fmt.Errorf("hub did not take the command within %v", hubCommandTimeout)
+	}
+	<-done
+	return nil
+}
+
 // Called when a client logins successfully
 func (h *Hub) RegisterUsername(username string, clientId uint64) {
 	h.usernameToClientRWMutex.Lock()
@@ -201,6 +272,22 @@ func (h *Hub) GetClientByUsername(username string) (Client, bool) {
 	return h.Clients.Get(clientId)
 }
 
+// Retrieves the client (if found) whose player character has that nickname
+func (h *Hub) GetClientByNickname(nickname string) (Client, bool) {
+	var found Client = nil
+	h.SharedObjects.Players.ForEachWithBreak(func(id uint64, player *objects.Player) bool {
+		if player.Name != nickname {
+			return false
+		}
+		client, exists := h.Clients.Get(id)
+		if exists {
+			found = client
+		}
+		return exists
+	})
+	return found, found != nil
+}
+
 // Returns true if the account is already logged in (registered in our Hub)
 func (h *Hub) IsAlreadyConnected(username string) bool {
 	h.usernameToClientRWMutex.RLock()
@@ -221,6 +308,7 @@ func (h *Hub) GetRegionById(id uint64) (*Region, bool) {
 // Creates a new region and adds it to Hub
 func (h *Hub) CreateRegion(name string, gameMap string, gridWidth uint64, gridHeight uint64, regionId uint64) {
 	region := CreateRegion(name, gameMap, gridWidth, gridHeight)
+	region.hub = h
 
 	switch regionId {
 	case 1: // Prototype [20x40]
@@ -230,17 +318,110 @@ func (h *Hub) CreateRegion(name string, gameMap string, gridWidth uint64, gridHe
 			{Position: &pathfinding.Cell{X: 0, Z: 39}, Rotation: objects.NORTHEAST},
 			{Position: &pathfinding.Cell{X: 19, Z: 39}, Rotation: objects.NORTHWEST},
 		}
+		// The open world is harsh, you come back hurt and with the ammo you had left
+		region.RespawnRules = RespawnRules{
+			Delay:         10 * time.Second,
+			Protection:    3 * time.Second,
+			HealthPercent: 50,
+			RefillAmmo:    false,
+		}
+		region.Obstacles = []*Obstacle{
+			{Name: "Stone Column", Cells: CellRect(12, 33, 12, 33), Cover: pathfinding.COVER_FULL},
+			{Name: "Concrete Wall", Cells: CellRect(9, 6, 10, 6), Cover: pathfinding.COVER_FULL},
+			// Sandbags in front of the raiders guarding the toxic pocket
+			{Name: "Sandbags", Cells: CellRect(3, 18, 5, 18), Cover: pathfinding.COVER_HALF},
+			{Name: "Sandbags", Cells: CellRect(14, 22, 16, 22), Cover: pathfinding.COVER_HALF},
+		}
+		region.LootTable = &LootTable{
+			Entries: []*LootEntry{
+				{ItemName: "medkit", MinQuantity: 1, MaxQuantity: 1, Weight: 20},
+				{ItemName: "rifle_ammo_box", MinQuantity: 1, MaxQuantity: 2, Weight: 30},
+				{ItemName: "shotgun_ammo_box", MinQuantity: 1, MaxQuantity: 2, Weight: 20},
+				{ItemName: "scrap_metal", MinQuantity: 2, MaxQuantity: 5, Weight: 25},
+				{ItemName: "light_helmet", MinQuantity: 1, MaxQuantity: 1, Weight: 5},
+				{ItemName: "gas_mask", MinQuantity: 1, MaxQuantity: 1, Weight: 5},
+			},
+			Spawns: []*pathfinding.Cell{
+				{X: 10, Z: 10},
+				{X: 5, Z: 20},
+				{X: 15, Z: 20},
+				{X: 10, Z: 30},
+			},
+			Interval: 30 * time.Second,
+			MaxItems: 4,
+		}
+		region.HazardZones = []*objects.HazardZone{
+			// Pocket of toxic Eden atmosphere in the middle of the map
+			{Name: "Toxic Pocket", Type: objects.HAZARD_TYPE_GAS, Cells: CellRect(8, 17, 11, 22),
+				Interval: time.Second, Damage: 3, DamageType: objects.DAMAGE_TYPE_POISON, StatusEffect: "poison"},
+			// Scourge contaminated corner, it hurts slowly but the exposure builds up
+			{Name: "Scourge Crater", Type: objects.HAZARD_TYPE_RADIATION, Cells: CellRect(15, 30, 19, 35),
+				Interval: 2 * time.Second, Damage: 1, DamageType: objects.DAMAGE_TYPE_RADIATION, StatusEffect: "scourge_exposure"},
+		}
+		region.NpcSpawns = []*NpcSpawn{
+			// Raiders guarding both sides of the toxic pocket
+			{Template: "raider", Position: &pathfinding.Cell{X: 4, Z: 20}, Rotation: objects.EAST},
+			{Template: "raider", Position: &pathfinding.Cell{X: 15, Z: 20}, Rotation: objects.WEST},
+			// A hound roaming next to the crater
+			{Template: "scourge_hound", Position: &pathfinding.Cell{X: 14, Z: 28}, Rotation: objects.NORTH},
+		}
+		region.NpcSpawners = []*NpcSpawner{
+			// Pack of hounds wandering the south west corner
+			{Template: "scourge_hound", Area: CellRect(2, 30, 8, 36), MaxAlive: 3, RespawnDelay: 45 * time.Second},
+			// Raider patrolling around the north half of the map
+			{Template: "raider", Area: CellRect(9, 2, 11, 4), MaxAlive: 1, RespawnDelay: time.Minute,
+				PatrolRoute: []*pathfinding.Cell{{X: 10, Z: 3}, {X: 3, Z: 10}, {X: 10, Z: 14}, {X: 17, Z: 10}}},
+		}
 	case 2: // Maze [10x10]
 		region.Respawners = []*Respawner{
 			{Position: &pathfinding.Cell{X: 0, Z: 0}, Rotation: objects.SOUTHEAST},
 			{Position: &pathfinding.Cell{X: 0, Z: 9}, Rotation: objects.NORTHEAST},
 		}
+		// The match decides the respawn delay, players come back ready to fight
+		region.RespawnRules = RespawnRules{
+			Protection:    2 * time.Second,
+			HealthPercent: 100,
+			RefillAmmo:    true,
+		}
+		region.Obstacles = []*Obstacle{
+			{Name: "Pillar", Cells: CellRect(3, 3, 3, 3), Cover: pathfinding.COVER_FULL},
+			{Name: "Pillar", Cells: CellRect(6, 6, 6, 6), Cover: pathfinding.COVER_FULL},
+		}
+		region.HazardZones = []*objects.HazardZone{
+			{Name: "Burning Corridor", Type: objects.HAZARD_TYPE_FIRE, Cells: CellRect(4, 4, 5, 5),
+				Interval: time.Second, Damage: 5, DamageType: objects.DAMAGE_TYPE_FIRE},
+		}
+		region.NpcSpawns = []*NpcSpawn{
+			{Template: "scourge_hound", Position: &pathfinding.Cell{X: 8, Z: 8}, Rotation: objects.NORTHWEST},
+		}
+		// Team deathmatch for the weekly scrims
+		region.Match = objects.CreateMatch(objects.MatchConfig{
+			Mode:           objects.MATCH_MODE_TEAM_DEATHMATCH,
+			MinPlayers:     2,
+			WarmupDuration: 30 * time.Second,
+			TimeLimit:      10 * time.Minute,
+			ScoreLimit:     30,
+			Teams:          2,
+			FriendlyFire:   false,
+			RespawnDelay:   5 * time.Second,
+			ResultsTime:    20 * time.Second,
+		})
+		region.NpcSpawners = []*NpcSpawner{
+			// Hound running laps around the maze
+			{Template: "scourge_hound", Area: CellRect(1, 1, 1, 1), MaxAlive: 1, RespawnDelay: 30 * time.Second,
+				PatrolRoute: []*pathfinding.Cell{{X: 8, Z: 1}, {X: 8, Z: 8}, {X: 1, Z: 8}, {X: 1, Z: 1}}},
+		}
 	}
 
 	// Dereference the pointer to add a REAL region object to the Hub's list of regions
 	// If this is the first region, h.Regions.Add returns 1, and the initial value for the region was 0
 	region.SetId(h.Regions.Add(region, regionId))
 
+	// Obstacles go first so nothing spawns inside them
+	region.PlaceObstacles()
+	// Place the NPCs after the ID is set so the logs show which region they belong to
+	region.SpawnNPCs()
+
 	// Start the region in a goroutine
 	go region.Start()
 }
@@ -255,7 +436,7 @@ func (h *Hub) JoinRegion(username string) {
 		// Load position data from the database for this player
 		spawnPosition, err := h.LoadCharacterPosition(client)
 		if err != nil {
-			log.Println("Error loading character position from DB: ", err)
+			h.logger.Error("Error loading character position from DB", logging.ClientId, client.GetId(), logging.Username, username, "error", err)
 		}
 
 		// Store the regionId and mapId from the database
@@ -284,7 +465,7 @@ func (h *Hub) JoinRegion(username string) {
 
 			// If we looped through the whole map and no cell was available
 			if playerSpawnCell == nil {
-				log.Printf("No more space available in region %d", regionId)
+				h.logger.Warn("No more space available in region", logging.Username, username, logging.RegionId, regionId)
 				// TO FIX
 				// This should teleport the client to the spawn map from the DB instead
 				h.SwitchRegion(username, 1, 1)
@@ -302,7 +483,7 @@ func (h *Hub) JoinRegion(username string) {
 			grid.SetObject(playerSpawnCell, player)
 
 		} else { // If the region does not exist
-			log.Printf("Region %d not available", regionId)
+			h.logger.Warn("Region not available", logging.Username, username, logging.RegionId, regionId)
 
 			// TO FIX
 			// This should teleport the client to the spawn map from the DB instead
@@ -358,7 +539,7 @@ func (h *Hub) SwitchRegion(username string, regionId uint64, mapId uint64) {
 
 			// If we looped through the whole map and no cell was available
 			if playerSpawnCell == nil {
-				log.Printf("No more space available in region %d", regionId)
+				h.logger.Warn("No more space available in region", logging.Username, username, logging.RegionId, regionId)
 				// TO FIX
 				// This should teleport the client to the spawn map from the DB instead
 				h.SwitchRegion(username, 1, 1)
@@ -376,7 +557,7 @@ func (h *Hub) SwitchRegion(username string, regionId uint64, mapId uint64) {
 			player.SetGridDestination(playerSpawnCell)
 
 		} else { // If the region does not exist
-			log.Printf("Region %d not available", regionId)
+			h.logger.Warn("Region not available", logging.Username, username, logging.RegionId, regionId)
 
 			// TO FIX
 			// This should teleport the client to the spawn map from the DB instead
@@ -410,7 +591,7 @@ func (h *Hub) CreateUser(username, nickname, passwordHash, gender string) (db.Us
 	}
 	defer tx.Rollback()
 
-	q := h.queries.WithTx(tx)
+	q := db.NewTimed(tx)
 
 	// Step 1: Create User (without character_id)
 	user, err := q.CreateUser(ctx, db.CreateUserParams{
@@ -503,13 +684,22 @@ func (h *Hub) CreateUser(username, nickname, passwordHash, gender string) (db.Us
 		return db.User{}, fmt.Errorf("bulk insert weapon slots: %w", err)
 	}
 
-	// Step 6: Get complete user data before committing
+	// Step 6: Give the new character some starting items
+	starterInventory := objects.CreateInventory(objects.INVENTORY_CAPACITY, objects.INVENTORY_MAX_WEIGHT)
+	starterInventory.AddItem("medkit", 2)
+	starterInventory.AddItem("rifle_ammo_box", 2)
+	starterInventory.AddItem("shotgun_ammo_box", 2)
+	if err := db.ReplaceInventoryItems(ctx, tx, character.ID, starterInventory.GetSlots()); err != nil {
+		return db.User{}, fmt.Errorf("insert starter inventory: %w", err)
+	}
+
+	// Step 7: Get complete user data before committing
 	fullUser, err := q.GetUserByID(ctx, user.ID)
 	if err != nil {
 		return db.User{}, fmt.Errorf("get user: %w", err)
 	}
 
-	// Step 7: Commit transaction
+	// Step 8: Commit transaction
 	if err := tx.Commit(); err != nil {
 		return db.User{}, fmt.Errorf("commit transaction: %w", err)
 	}
@@ -540,6 +730,8 @@ func (h *Hub) SaveCharacter(client Client) error {
 		return fmt.Errorf("begin transaction: %w", err)
 	}
 	defer tx.Rollback()
+	// Every write goes through the transaction, the character row is saved with everything else or not at all
+	q := db.NewTimed(tx)
 
 	character := client.GetPlayerCharacter()
 
@@ -550,7 +742,7 @@ func (h *Hub) SaveCharacter(client Client) error {
 	}
 
 	// Save character data
-	err = h.queries.UpdateFullCharacterData(ctx, db.UpdateFullCharacterDataParams{
+	err = q.UpdateFullCharacterData(ctx, db.UpdateFullCharacterDataParams{
 		RegionID:    int64(character.GetRegionId()),
 		MapID:       int64(character.GetMapId()),
 		X:           int64(character.GetGridPosition().X),
@@ -561,6 +753,8 @@ func (h *Hub) SaveCharacter(client Client) error {
 		RotationY:   float64(character.GetRotation()),
 		WeaponSlot:  int64(character.GetCurrentWeapon()),
 		IsCrouching: isCrouching,
+		Level:       int64(character.Level),
+		Experience:  int64(character.Experience),
 		ID:          client.GetCharacterId(), // Character ID to find it in the DB
 	})
 	if err != nil {
@@ -573,8 +767,31 @@ func (h *Hub) SaveCharacter(client Client) error {
 		return fmt.Errorf("bulk insert weapon slots: %w", err)
 	}
 
+	// Save the inventory
+	if err := db.ReplaceInventoryItems(ctx, tx, client.GetCharacterId(), character.GetInventory().GetSlots()); err != nil {
+		return fmt.Errorf("save inventory: %w", err)
+	}
+
+	// Save the equipped armor
+	armor := make([]*objects.InventoryItem, objects.MAX_ARMOR_SLOTS)
+	for slot := range objects.MAX_ARMOR_SLOTS {
+		armor[slot] = character.GetArmor(slot)
+	}
+	if err := db.ReplaceArmorPieces(ctx, tx, client.GetCharacterId(), armor); err != nil {
+		return fmt.Errorf("save armor: %w", err)
+	}
+
+	// Add the combat stats recorded since the last save to the totals,
+	// if anything fails we keep them so the next save can try again
+	stats := character.TakeUnsavedStats()
+	if err := saveCombatStats(ctx, q, client.GetCharacterId(), stats); err != nil {
+		character.GetUnsavedStats().Add(stats)
+		return fmt.Errorf("save combat stats: %w", err)
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
+		character.GetUnsavedStats().Add(stats)
 		return fmt.Errorf("commit transaction: %w", err)
 	}
 
@@ -663,3 +880,57 @@ func (h *Hub) LoadWeaponSlots(characterId int64) (*[]*objects.WeaponSlot, error)
 
 	return &slots, nil
 }
+
+// Get the general items this character carries from the database
+func (h *Hub) LoadInventory(characterId int64) (*objects.Inventory, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := h.queries.LoadInventoryItems(ctx, characterId)
+	if err != nil {
+		return nil, fmt.Errorf("load inventory: %w", err)
+	}
+
+	inventory := objects.CreateInventory(objects.INVENTORY_CAPACITY, objects.INVENTORY_MAX_WEIGHT)
+	for _, row := range rows {
+		// Skip rows for items that were removed from the catalog
+		if _, exists := objects.GetItemStats(row.ItemName); !exists || row.Quantity <= 0 {
+			continue
+		}
+		inventory.SetSlot(uint64(row.SlotIndex), &objects.InventoryItem{
+			ItemName: row.ItemName,
+			Quantity: uint64(row.Quantity),
+			Wear:     uint64(row.Wear),
+			Ammo:     uint64(row.Ammo),
+		})
+	}
+
+	return inventory, nil
+}
+
+// Get the armor pieces this character has equipped from the database, empty slots are nil
+func (h *Hub) LoadArmor(characterId int64) ([]*objects.InventoryItem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := h.queries.LoadArmorPieces(ctx, characterId)
+	if err != nil {
+		return nil, fmt.Errorf("load armor: %w", err)
+	}
+
+	armor := make([]*objects.InventoryItem, objects.MAX_ARMOR_SLOTS)
+	for _, row := range rows {
+		// Skip pieces that were removed from the catalog or are in the wrong slot
+		stats, exists := objects.GetItemStats(row.ItemName)
+		if !exists || stats.Type != objects.ITEM_TYPE_ARMOR || stats.ArmorSlot != uint64(row.SlotIndex) {
+			continue
+		}
+		armor[row.SlotIndex] = &objects.InventoryItem{
+			ItemName: row.ItemName,
+			Quantity: 1,
+			Wear:     uint64(row.Wear),
+		}
+	}
+
+	return armor, nil
+}
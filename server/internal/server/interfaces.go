@@ -19,7 +19,7 @@ type Client interface {
 	// Handles the client's packet
 	ProcessPacket(senderId uint64, packet packets.Payload)
 
-	// Puts data from the client into the write pump
+	// Puts data from the client into the write pump, safe from any goroutine, even after Close (the packet is dropped)
 	SendPacket(packet packets.Payload)
 
 	// Puts data from another client in the write pump
@@ -59,7 +59,8 @@ type Client interface {
 	GetRegion() *Region
 	SetRegion(*Region)
 
-	// Close the client's connection and cleanup
+	// Close the client's connection and cleanup, only the first call counts
+	// Uses Hub.Do, so call it from any goroutine except the hub's own
 	Close(reason string)
 }
 
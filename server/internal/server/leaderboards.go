@@ -0,0 +1,214 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const leaderboardRefreshInterval time.Duration = time.Minute // Leaderboards are rebuilt from the database this often
+const leaderboardMaxEntries int64 = 100                      // Characters kept in each leaderboard
+const leaderboardPageSize uint64 = 10                        // Entries per page, in packets and in the HTTP endpoint
+const leaderboardMinKillsForRatio int64 = 10                 // Kills needed to appear in the kill/death ratio leaderboard
+
+// Latest snapshot of every leaderboard, so neither players nor the website hit the database on every request
+type LeaderboardCache struct {
+	boards map[string]*objects.Leaderboard
+	mutex  sync.RWMutex // The HTTP endpoint reads it from other goroutines
+}
+
+// Returns the latest snapshot of a leaderboard
+func (h *Hub) GetLeaderboard(category string) (*objects.Leaderboard, bool) {
+	h.leaderboards.mutex.RLock()
+	defer h.leaderboards.mutex.RUnlock()
+	leaderboard, exists := h.leaderboards.boards[category]
+	return leaderboard, exists
+}
+
+// Rebuilds the leaderboards right away and then every refresh interval, runs on its own goroutine
+// so the hub doesn't wait for the database
+func (h *Hub) refreshLeaderboardsLoop() {
+	ticker := time.NewTicker(leaderboardRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := h.RefreshLeaderboards(); err != nil {
+			h.logger.Error("Failed to refresh leaderboards", "error", err)
+		}
+		<-ticker.C
+	}
+}
+
+// Rebuilds every leaderboard from the saved stats and swaps them all at once
+func (h *Hub) RefreshLeaderboards() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	boards := make(map[string]*objects.Leaderboard)
+
+	kills, err := h.queries.GetTopKills(ctx, leaderboardMaxEntries)
+	if err != nil {
+		return fmt.Errorf("get top kills: %w", err)
+	}
+	board := &objects.Leaderboard{Category: objects.LEADERBOARD_KILLS, UpdatedAt: now}
+	for i, row := range kills {
+		board.Entries = append(board.Entries, &objects.LeaderboardEntry{
+			Rank:        uint64(i + 1),
+			CharacterId: row.CharacterID,
+			Nickname:    row.Nickname,
+			Value:       float64(row.Kills),
+		})
+	}
+	boards[board.Category] = board
+
+	ratios, err := h.queries.GetTopKillDeathRatio(ctx, db.GetTopKillDeathRatioParams{
+		Kills: leaderboardMinKillsForRatio,
+		Limit: leaderboardMaxEntries,
+	})
+	if err != nil {
+		return fmt.Errorf("get top kill/death ratio: %w", err)
+	}
+	board = &objects.Leaderboard{Category: objects.LEADERBOARD_KILL_DEATH_RATIO, UpdatedAt: now}
+	for i, row := range ratios {
+		// Never dying counts as dying once, like in the query
+		board.Entries = append(board.Entries, &objects.LeaderboardEntry{
+			Rank:        uint64(i + 1),
+			CharacterId: row.CharacterID,
+			Nickname:    row.Nickname,
+			Value:       float64(row.Kills) / float64(max(row.Deaths, 1)),
+		})
+	}
+	boards[board.Category] = board
+
+	levels, err := h.queries.GetTopLevels(ctx, leaderboardMaxEntries)
+	if err != nil {
+		return fmt.Errorf("get top levels: %w", err)
+	}
+	board = &objects.Leaderboard{Category: objects.LEADERBOARD_LEVEL, UpdatedAt: now}
+	for i, row := range levels {
+		// Ties on level are broken by experience in the query
+		board.Entries = append(board.Entries, &objects.LeaderboardEntry{
+			Rank:        uint64(i + 1),
+			CharacterId: row.CharacterID,
+			Nickname:    row.Nickname,
+			Value:       float64(row.Level),
+		})
+	}
+	boards[board.Category] = board
+
+	wins, err := h.queries.GetTopMatchWins(ctx, leaderboardMaxEntries)
+	if err != nil {
+		return fmt.Errorf("get top match wins: %w", err)
+	}
+	board = &objects.Leaderboard{Category: objects.LEADERBOARD_MATCH_WINS, UpdatedAt: now}
+	for i, row := range wins {
+		board.Entries = append(board.Entries, &objects.LeaderboardEntry{
+			Rank:        uint64(i + 1),
+			CharacterId: row.CharacterID,
+			Nickname:    row.Nickname,
+			Value:       float64(row.Wins),
+		})
+	}
+	boards[board.Category] = board
+
+	h.leaderboards.mutex.Lock()
+	h.leaderboards.boards = boards
+	h.leaderboards.mutex.Unlock()
+
+	return nil
+}
+
+// Sends a page of a leaderboard to this client
+func (h *Hub) SendLeaderboardPage(client Client, category string, page uint64) error {
+	if !slices.Contains(objects.LEADERBOARD_CATEGORIES, category) {
+		return errors.New("unknown leaderboard")
+	}
+	leaderboard, exists := h.GetLeaderboard(category)
+	if !exists {
+		return errors.New("leaderboards are not ready yet")
+	}
+
+	client.SendPacket(packets.NewLeaderboardResponse(leaderboard, page, leaderboardPageSize))
+	return nil
+}
+
+// JSON sent by the HTTP endpoint
+type leaderboardEntryJSON struct {
+	Rank     uint64  `json:"rank"`
+	Nickname string  `json:"nickname"`
+	Value    float64 `json:"value"`
+}
+type leaderboardPageJSON struct {
+	Category  string                 `json:"category"`
+	Page      uint64                 `json:"page"`
+	PageCount uint64                 `json:"page_count"`
+	UpdatedAt int64                  `json:"updated_at"`
+	Entries   []leaderboardEntryJSON `json:"entries"`
+}
+
+// HTTP endpoint for the community site: GET /leaderboards?category=kills&page=1
+// Everything comes from the cache, so the site can poll it as much as it wants
+func (h *Hub) ServeLeaderboards(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	category := request.URL.Query().Get("category")
+	if category == "" {
+		category = objects.LEADERBOARD_KILLS
+	}
+	if !slices.Contains(objects.LEADERBOARD_CATEGORIES, category) {
+		http.Error(writer, "unknown leaderboard", http.StatusNotFound)
+		return
+	}
+
+	page := uint64(1)
+	if pageParam := request.URL.Query().Get("page"); pageParam != "" {
+		parsed, err := strconv.ParseUint(pageParam, 10, 64)
+		if err != nil || parsed == 0 {
+			http.Error(writer, "invalid page", http.StatusBadRequest)
+			return
+		}
+		page = parsed
+	}
+
+	leaderboard, exists := h.GetLeaderboard(category)
+	if !exists {
+		http.Error(writer, "leaderboards are not ready yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	response := leaderboardPageJSON{
+		Category:  leaderboard.Category,
+		Page:      page,
+		PageCount: leaderboard.GetPageCount(leaderboardPageSize),
+		UpdatedAt: leaderboard.UpdatedAt.Unix(),
+		Entries:   []leaderboardEntryJSON{},
+	}
+	for _, entry := range leaderboard.GetPage(page, leaderboardPageSize) {
+		response.Entries = append(response.Entries, leaderboardEntryJSON{
+			Rank:     entry.Rank,
+			Nickname: entry.Nickname,
+			Value:    entry.Value,
+		})
+	}
+
+	// Browsers and proxies can keep it until the next refresh
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(leaderboardRefreshInterval.Seconds())))
+	writer.Header().Set("Access-Control-Allow-Origin", "*")
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		h.logger.Warn("Failed to send leaderboard", "error", err)
+	}
+}
@@ -0,0 +1,198 @@
+// Structured, leveled logging for the whole server, built on log/slog.
+// Every component asks for its own logger with For(subsystem) and adds the fields it knows about
+// (client_id, username, region_id, state, packet_type) so logs can be filtered and searched.
+// Setup must run before any logger is created, anything created earlier keeps the default output.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Field names shared by every subsystem, always use these instead of typing the key
+const (
+	ClientId   string = "client_id"
+	Username   string = "username"
+	RegionId   string = "region_id"
+	State      string = "state"
+	PacketType string = "packet_type"
+	Subsystem  string = "subsystem"
+)
+
+// Subsystems that can get their own level
+const (
+	Hub      string = "hub"
+	Clients  string = "clients"
+	States   string = "states"
+	Regions  string = "regions"
+	Admin    string = "admin"
+	Recorder string = "recorder"
+)
+
+// Every subsystem above, used to catch typos in the level settings
+var Subsystems = []string{Hub, Clients, States, Regions, Admin, Recorder}
+
+// Where and how the logs are written
+type Config struct {
+	Format     string                // "text" or "json"
+	Level      slog.Level            // Level of every subsystem not listed in Levels
+	Levels     map[string]slog.Level // Level per subsystem, e.g. regions=debug
+	File       string                // Writes to this file instead of stderr when not empty
+	MaxSize    int64                 // Bytes, the file is rotated once it grows past this (0 never rotates)
+	MaxBackups int                   // Rotated files to keep, e.g. server.log.1 ... server.log.5
+}
+
+var (
+	mutex        sync.Mutex
+	root         slog.Handler                      // Formats and writes every record, nil until Setup
+	defaultLevel = &slog.LevelVar{}                // Used by subsystems without their own level
+	levels       = make(map[string]*slog.LevelVar) // One per subsystem, created on demand
+	overridden   = make(map[string]bool)           // Subsystems with a level of their own
+)
+
+// Configures the output of every logger created from now on, plain log.Printf calls included.
+// Returns the log file so it can be closed on shutdown, nil when logging to stderr (Close is nil-safe).
+func Setup(config Config) (*RotatingFile, error) {
+	var output io.Writer = os.Stderr
+	var file *RotatingFile
+	if config.File != "" {
+		var err error
+		file, err = OpenRotatingFile(config.File, config.MaxSize, config.MaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		output = file
+	}
+
+	// The root handler lets everything through, levels are checked per subsystem before it
+	options := &slog.HandlerOptions{Level: slog.LevelDebug}
+	var handler slog.Handler
+	switch strings.ToLower(config.Format) {
+	case "", "text":
+		handler = slog.NewTextHandler(output, options)
+	case "json":
+		handler = slog.NewJSONHandler(output, options)
+	default:
+		file.Close()
+		return nil, fmt.Errorf("unknown log format %q, use text or json", config.Format)
+	}
+
+	mutex.Lock()
+	root = handler
+	defaultLevel.Set(config.Level)
+	clear(overridden)
+	for _, level := range levels {
+		level.Set(config.Level)
+	}
+	for subsystem, level := range config.Levels {
+		levelVar(subsystem).Set(level)
+		overridden[subsystem] = true
+	}
+	mutex.Unlock()
+
+	// Whatever still uses the log package goes through the same output
+	slog.SetDefault(slog.New(&levelHandler{level: defaultLevel, handler: handler}))
+	return file, nil
+}
+
+// Logger for a subsystem, every record has the subsystem field and is filtered by its level
+func For(subsystem string) *slog.Logger {
+	mutex.Lock()
+	handler := root
+	level := levelVar(subsystem)
+	mutex.Unlock()
+
+	if handler == nil {
+		// Setup was never called (tools, tests), keep the default output
+		handler = slog.Default().Handler()
+	}
+	return slog.New(&levelHandler{level: level, handler: handler}).With(Subsystem, subsystem)
+}
+
+// Changes the level of a subsystem while the server runs, an empty subsystem changes the default
+func SetLevel(subsystem string, level slog.Level) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if subsystem == "" {
+		defaultLevel.Set(level)
+		for name, levelVar := range levels {
+			if !overridden[name] {
+				levelVar.Set(level)
+			}
+		}
+		return
+	}
+	levelVar(subsystem).Set(level)
+	overridden[subsystem] = true
+}
+
+// Parses "info" or "info,regions=debug,states=warn" into the default level and the per subsystem levels
+func ParseLevels(spec string) (slog.Level, map[string]slog.Level, error) {
+	level := slog.LevelInfo
+	subsystems := make(map[string]slog.Level)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		subsystem, name, found := strings.Cut(part, "=")
+		if !found {
+			name, subsystem = subsystem, ""
+		}
+		var parsed slog.Level
+		if err := parsed.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+			return level, nil, fmt.Errorf("invalid log level %q: %w", part, err)
+		}
+
+		subsystem = strings.TrimSpace(subsystem)
+		if subsystem == "" {
+			level = parsed
+		} else if slices.Contains(Subsystems, subsystem) {
+			subsystems[subsystem] = parsed
+		} else {
+			return level, nil, fmt.Errorf("unknown log subsystem %q, use one of %s", subsystem, strings.Join(Subsystems, ", "))
+		}
+	}
+	return level, subsystems, nil
+}
+
+// Must hold the mutex
+func levelVar(subsystem string) *slog.LevelVar {
+	level, exists := levels[subsystem]
+	if !exists {
+		level = &slog.LevelVar{}
+		level.Set(defaultLevel.Level())
+		levels[subsystem] = level
+	}
+	return level
+}
+
+// Drops records below the level of its subsystem before they reach the shared handler
+type levelHandler struct {
+	level   slog.Leveler
+	handler slog.Handler
+}
+
+func (h *levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level.Level() && h.handler.Enabled(ctx, level)
+}
+
+func (h *levelHandler) Handle(ctx context.Context, record slog.Record) error {
+	return h.handler.Handle(ctx, record)
+}
+
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &levelHandler{level: h.level, handler: h.handler.WithAttrs(attrs)}
+}
+
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{level: h.level, handler: h.handler.WithGroup(name)}
+}
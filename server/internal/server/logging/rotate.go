@@ -0,0 +1,111 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Log file that moves itself aside once it gets too big: server.log becomes server.log.1,
+// server.log.1 becomes server.log.2 and so on, the oldest one past MaxBackups is deleted.
+// Every method is safe to call on a nil file.
+type RotatingFile struct {
+	path       string
+	maxSize    int64 // Bytes, 0 never rotates
+	maxBackups int
+	file       *os.File
+	size       int64
+	mutex      sync.Mutex // Every goroutine logs through the same file
+}
+
+// Opens (or creates) the log file, appending to what is already there
+func OpenRotatingFile(path string, maxSize int64, maxBackups int) (*RotatingFile, error) {
+	r := &RotatingFile{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *RotatingFile) Write(data []byte) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	// Never rotate an empty file, a single huge record still has to go somewhere
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(data)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			// Keep writing to the old file rather than losing logs
+			fmt.Fprintf(os.Stderr, "Failed to rotate log file %s: %v\n", r.path, err)
+		}
+	}
+
+	written, err := r.file.Write(data)
+	r.size += int64(written)
+	return written, err
+}
+
+// Closes the file, writes after this fail
+func (r *RotatingFile) Close() error {
+	if r == nil {
+		return nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// Must hold the mutex
+func (r *RotatingFile) open() error {
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("reading log file size: %w", err)
+	}
+	r.file = file
+	r.size = info.Size()
+	return nil
+}
+
+// Must hold the mutex
+func (r *RotatingFile) rotate() error {
+	err := r.file.Close()
+	r.file = nil
+	if err != nil {
+		return err
+	}
+
+	// Shift every backup up by one, from the oldest down, the last one falls off
+	if r.maxBackups > 0 {
+		os.Remove(r.backupPath(r.maxBackups))
+		for i := r.maxBackups - 1; i >= 1; i-- {
+			os.Rename(r.backupPath(i), r.backupPath(i+1))
+		}
+		if err := os.Rename(r.path, r.backupPath(1)); err != nil {
+			r.open()
+			return err
+		}
+	} else if err := os.Remove(r.path); err != nil {
+		r.open()
+		return err
+	}
+
+	return r.open()
+}
+
+func (r *RotatingFile) backupPath(index int) string {
+	return fmt.Sprintf("%s.%d", r.path, index)
+}
@@ -0,0 +1,200 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"server/internal/server/db"
+	"server/internal/server/logging"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// Runs the match of this region (if it has one), called every tick by the hub
+func (r *Region) updateMatch(now time.Time) {
+	match := r.Match
+	if match == nil {
+		return
+	}
+
+	r.syncMatchParticipants(now)
+	r.respawnMatchPlayers(now)
+
+	switch match.Phase {
+	case objects.MATCH_PHASE_LOBBY:
+		if match.GetParticipantCount() >= match.Config.MinPlayers {
+			r.setMatchPhase(objects.MATCH_PHASE_WARMUP, now, match.Config.WarmupDuration)
+		}
+
+	case objects.MATCH_PHASE_WARMUP:
+		if match.GetParticipantCount() < match.Config.MinPlayers {
+			r.setMatchPhase(objects.MATCH_PHASE_LOBBY, now, 0)
+		} else if match.IsPhaseOver(now) {
+			r.startMatch(now)
+		}
+
+	case objects.MATCH_PHASE_LIVE:
+		// Everyone left, nothing worth saving
+		if match.GetParticipantCount() == 0 {
+			r.setMatchPhase(objects.MATCH_PHASE_LOBBY, now, 0)
+		} else if match.IsPhaseOver(now) || match.IsScoreLimitReached() {
+			r.endMatch(now)
+		}
+
+	case objects.MATCH_PHASE_ENDED:
+		if match.IsPhaseOver(now) {
+			match.ResetScores()
+			r.setMatchPhase(objects.MATCH_PHASE_LOBBY, now, 0)
+		}
+	}
+}
+
+// Adds the clients that joined this region to the match and removes the ones that left
+func (r *Region) syncMatchParticipants(now time.Time) {
+	match := r.Match
+	changed := false
+
+	r.Clients.ForEach(func(id uint64, client Client) {
+		if _, exists := match.GetParticipant(id); exists {
+			return
+		}
+		player := client.GetPlayerCharacter()
+		if player == nil {
+			return
+		}
+
+		score := match.AddParticipant(id, client.GetCharacterId(), player.Name)
+		client.SendPacket(packets.NewMatchState(match, score.Team, now))
+		changed = true
+	})
+
+	for _, score := range match.GetScores() {
+		if _, exists := r.GetClient(score.ClientId); !exists {
+			match.RemoveParticipant(score.ClientId)
+			changed = true
+		}
+	}
+
+	if changed {
+		r.SendToAll(packets.NewScoreboard(match, now))
+	}
+}
+
+// Brings back the participants whose respawn timer is over
+func (r *Region) respawnMatchPlayers(now time.Time) {
+	for _, clientId := range r.Match.PopDueRespawns(now) {
+		client, exists := r.GetClient(clientId)
+		if !exists || client.GetPlayerCharacter().IsAlive() {
+			continue
+		}
+		if err := r.RespawnPlayer(client, nil); err != nil {
+			r.logger.Warn("Failed to respawn", logging.ClientId, clientId, logging.Username, client.GetAccountUsername(), "error", err)
+		}
+	}
+}
+
+// Changes the match phase and tells every participant
+func (r *Region) setMatchPhase(phase string, now time.Time, duration time.Duration) {
+	match := r.Match
+	match.SetPhase(phase, now, duration)
+
+	r.Clients.ForEach(func(id uint64, client Client) {
+		team := objects.NO_TEAM
+		if score, exists := match.GetParticipant(id); exists {
+			team = score.Team
+		}
+		client.SendPacket(packets.NewMatchState(match, team, now))
+	})
+	r.SendToAll(packets.NewScoreboard(match, now))
+
+	r.logger.Info("Match phase changed", "phase", phase)
+}
+
+// Clears the scores and puts everyone back on a respawner with full health
+func (r *Region) startMatch(now time.Time) {
+	r.Match.ResetScores()
+
+	r.Clients.ForEach(func(id uint64, client Client) {
+		if err := r.RespawnPlayer(client, nil); err != nil {
+			r.logger.Warn("Failed to respawn", logging.ClientId, id, logging.Username, client.GetAccountUsername(), "error", err)
+		}
+	})
+
+	r.setMatchPhase(objects.MATCH_PHASE_LIVE, now, r.Match.Config.TimeLimit)
+}
+
+// Shows the results and saves them
+func (r *Region) endMatch(now time.Time) {
+	r.setMatchPhase(objects.MATCH_PHASE_ENDED, now, r.Match.Config.ResultsTime)
+
+	// Winners still in the region get their experience, it's saved with the rest of the character
+	for _, score := range r.Match.GetScores() {
+		if client, exists := r.GetClient(score.ClientId); exists && r.Match.IsWinner(score) {
+			r.GiveExperience(client, objects.EXPERIENCE_PER_MATCH_WIN)
+		}
+	}
+
+	if r.hub == nil {
+		return
+	}
+	if err := r.hub.SaveMatchResults(r.GetId(), r.Match, now); err != nil {
+		r.logger.Error("Failed to save match results", "error", err)
+	}
+}
+
+// Scores a death and schedules the automatic respawn of the victim
+func (r *Region) recordMatchKill(attackerId, targetId uint64) {
+	match := r.Match
+	if match.RecordKill(attackerId, targetId) {
+		r.SendToAll(packets.NewScoreboard(match, time.Now()))
+	}
+	match.ScheduleRespawn(targetId, time.Now())
+}
+
+// Saves the results of a finished match and the score of every participant
+func (h *Hub) SaveMatchResults(regionId uint64, match *objects.Match, endedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.Database.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	q := db.NewTimed(tx)
+
+	result, err := q.CreateMatch(ctx, db.CreateMatchParams{
+		RegionID:   int64(regionId),
+		Mode:       match.Config.Mode,
+		StartedAt:  match.StartedAt.Unix(),
+		EndedAt:    endedAt.Unix(),
+		WinnerTeam: int64(match.GetWinningTeam()),
+	})
+	if err != nil {
+		return fmt.Errorf("create match: %w", err)
+	}
+
+	for _, score := range match.GetScores() {
+		won := int64(0)
+		if match.IsWinner(score) {
+			won = 1
+		}
+		err := q.InsertMatchPlayer(ctx, db.InsertMatchPlayerParams{
+			MatchID:     result.ID,
+			CharacterID: score.CharacterId,
+			Team:        int64(score.Team),
+			Kills:       int64(score.Kills),
+			Deaths:      int64(score.Deaths),
+			Won:         won,
+		})
+		if err != nil {
+			return fmt.Errorf("insert match player: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
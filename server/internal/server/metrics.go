@@ -0,0 +1,46 @@
+package server
+
+import (
+	"net/http"
+	"server/internal/server/metrics"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Prometheus text format with the live gauges of the hub followed by every counter and histogram
+func (h *Hub) ServeMetrics(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	// Connections, including clients that haven't logged in yet
+	metrics.WriteGauge(writer, "connected_clients", "Clients connected to the hub", nil,
+		[]metrics.Sample{{Value: float64(h.GetConnectedClients())}})
+
+	// Logged in accounts, the map is written by the hub goroutine so we lock it
+	h.usernameToClientRWMutex.RLock()
+	accounts := len(h.usernameToClient)
+	h.usernameToClientRWMutex.RUnlock()
+	metrics.WriteGauge(writer, "connected_accounts", "Accounts logged in", nil,
+		[]metrics.Sample{{Value: float64(accounts)}})
+
+	// Players in each region
+	regions := []metrics.Sample{}
+	h.Regions.ForEach(func(id uint64, region *Region) {
+		regions = append(regions, metrics.Sample{
+			Labels: []string{strconv.FormatUint(id, 10), region.Name},
+			Value:  float64(region.Clients.Len()),
+		})
+	})
+	// Map order is random, keep the output stable between scrapes
+	slices.SortFunc(regions, func(a, b metrics.Sample) int {
+		return strings.Compare(a.Labels[0], b.Labels[0])
+	})
+	metrics.WriteGauge(writer, "region_players", "Players in each region", []string{"region_id", "region"}, regions)
+
+	metrics.Write(writer)
+}
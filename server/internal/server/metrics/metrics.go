@@ -0,0 +1,273 @@
+// Small Prometheus compatible metrics, counters and histograms are registered once as package
+// variables and written in the text exposition format by the /metrics endpoint.
+// Gauges are computed when scraped, see Hub.ServeMetrics.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Every metric name starts with this
+const Namespace string = "outstar"
+
+// Buckets in seconds for fast operations (packets, queries, path searches)
+var FastBuckets = []float64{0.00001, 0.00005, 0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1}
+
+// Buckets in seconds for the hub tick, a tick at 30 Hz has ~33ms to finish
+var TickBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.02, 0.033, 0.05, 0.1, 0.25}
+
+var (
+	// Hub loop
+	HubTickSeconds = NewHistogram("hub_tick_seconds", "Time spent processing packets and updating regions on each hub tick", TickBuckets)
+
+	// Packets
+	PacketsReceived = NewCounter("packets_received_total", "Packets received from clients", "type")
+	PacketsSent     = NewCounter("packets_sent_total", "Packets written to clients", "type")
+	PacketsDropped  = NewCounter("packets_dropped_total", "Packets dropped because a client channel was full or the client went over its rate limit", "channel", "type")
+
+	// Flood protection
+	RateLimitActions = NewCounter("rate_limit_actions_total", "Warnings, kicks and bans given to flooding clients", "action")
+
+	// Database
+	DatabaseQuerySeconds = NewHistogram("db_query_seconds", "Database query latency", FastBuckets, "query")
+
+	// Pathfinding
+	AStarSeconds = NewHistogram("astar_search_seconds", "Time spent on each A* path search", FastBuckets)
+)
+
+// Anything that can write itself to the /metrics endpoint
+type collector interface {
+	getName() string
+	write(writer io.Writer)
+}
+
+// Every counter and histogram created with NewCounter or NewHistogram
+var registry = struct {
+	collectors []collector
+	mutex      sync.Mutex
+}{}
+
+func register(c collector) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.collectors = append(registry.collectors, c)
+}
+
+// Writes every registered metric, sorted by name
+func Write(writer io.Writer) {
+	registry.mutex.Lock()
+	collectors := slices.Clone(registry.collectors)
+	registry.mutex.Unlock()
+
+	slices.SortFunc(collectors, func(a, b collector) int {
+		return strings.Compare(a.getName(), b.getName())
+	})
+	for _, c := range collectors {
+		c.write(writer)
+	}
+}
+
+// A single gauge value, used by WriteGauge
+type Sample struct {
+	Labels []string // Label values, same order as the label names
+	Value  float64
+}
+
+// Writes a gauge that is computed at scrape time
+func WriteGauge(writer io.Writer, name string, help string, labels []string, samples []Sample) {
+	name = Namespace + "_" + name
+	writeHeader(writer, name, help, "gauge")
+	for _, sample := range samples {
+		fmt.Fprintf(writer, "%s%s %s\n", name, formatLabels(labels, sample.Labels, ""), formatValue(sample.Value))
+	}
+}
+
+// Counter split by label values, e.g. packets sent by type
+type Counter struct {
+	name   string
+	help   string
+	labels []string
+	values map[string]*counterValue // Keyed by the joined label values
+	mutex  sync.Mutex
+}
+
+type counterValue struct {
+	labels []string
+	value  uint64
+}
+
+// Creates and registers a counter, the name gets the namespace prepended
+func NewCounter(name string, help string, labels ...string) *Counter {
+	counter := &Counter{
+		name:   Namespace + "_" + name,
+		help:   help,
+		labels: labels,
+		values: make(map[string]*counterValue),
+	}
+	register(counter)
+	return counter
+}
+
+// Adds one, pass one value for each label
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Adds any amount, pass one value for each label
+func (c *Counter) Add(amount uint64, labelValues ...string) {
+	key := strings.Join(labelValues, "\xff")
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	value, exists := c.values[key]
+	if !exists {
+		value = &counterValue{labels: slices.Clone(labelValues)}
+		c.values[key] = value
+	}
+	value.value += amount
+}
+
+func (c *Counter) getName() string {
+	return c.name
+}
+
+func (c *Counter) write(writer io.Writer) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	writeHeader(writer, c.name, c.help, "counter")
+	for _, key := range sortedKeys(c.values) {
+		value := c.values[key]
+		fmt.Fprintf(writer, "%s%s %d\n", c.name, formatLabels(c.labels, value.labels, ""), value.value)
+	}
+}
+
+// Histogram in seconds split by label values
+type Histogram struct {
+	name    string
+	help    string
+	labels  []string
+	buckets []float64 // Upper bounds, sorted
+	values  map[string]*histogramValue
+	mutex   sync.Mutex
+}
+
+type histogramValue struct {
+	labels []string
+	counts []uint64 // One per bucket, not cumulative (the +Inf bucket is the total count)
+	count  uint64
+	sum    float64
+}
+
+// Creates and registers a histogram, the name gets the namespace prepended
+func NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	histogram := &Histogram{
+		name:    Namespace + "_" + name,
+		help:    help,
+		labels:  labels,
+		buckets: slices.Sorted(slices.Values(buckets)),
+		values:  make(map[string]*histogramValue),
+	}
+	register(histogram)
+	return histogram
+}
+
+// Records a duration, pass one value for each label
+func (h *Histogram) Observe(duration time.Duration, labelValues ...string) {
+	seconds := duration.Seconds()
+	key := strings.Join(labelValues, "\xff")
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	value, exists := h.values[key]
+	if !exists {
+		value = &histogramValue{labels: slices.Clone(labelValues), counts: make([]uint64, len(h.buckets))}
+		h.values[key] = value
+	}
+
+	// Only the first bucket that fits, they are added up when written
+	for i, bound := range h.buckets {
+		if seconds <= bound {
+			value.counts[i]++
+			break
+		}
+	}
+	value.count++
+	value.sum += seconds
+}
+
+// Records the time since start, meant to be deferred
+func (h *Histogram) Since(start time.Time, labelValues ...string) {
+	h.Observe(time.Since(start), labelValues...)
+}
+
+func (h *Histogram) getName() string {
+	return h.name
+}
+
+func (h *Histogram) write(writer io.Writer) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	writeHeader(writer, h.name, h.help, "histogram")
+	for _, key := range sortedKeys(h.values) {
+		value := h.values[key]
+		var cumulative uint64
+		for i, bound := range h.buckets {
+			cumulative += value.counts[i]
+			fmt.Fprintf(writer, "%s_bucket%s %d\n", h.name, formatLabels(h.labels, value.labels, formatValue(bound)), cumulative)
+		}
+		fmt.Fprintf(writer, "%s_bucket%s %d\n", h.name, formatLabels(h.labels, value.labels, "+Inf"), value.count)
+		fmt.Fprintf(writer, "%s_sum%s %s\n", h.name, formatLabels(h.labels, value.labels, ""), formatValue(value.sum))
+		fmt.Fprintf(writer, "%s_count%s %d\n", h.name, formatLabels(h.labels, value.labels, ""), value.count)
+	}
+}
+
+func writeHeader(writer io.Writer, name string, help string, metricType string) {
+	fmt.Fprintf(writer, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(writer, "# TYPE %s %s\n", name, metricType)
+}
+
+// Builds {name="value",...}, le is added for histogram buckets when not empty
+func formatLabels(names []string, values []string, le string) string {
+	pairs := []string{}
+	for i, name := range names {
+		value := ""
+		if i < len(values) {
+			value = values[i]
+		}
+		pairs = append(pairs, fmt.Sprintf("%s=%q", name, value))
+	}
+	if le != "" {
+		pairs = append(pairs, fmt.Sprintf("le=%q", le))
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatValue(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func sortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"time"
+)
+
+// Bans or mutes an account, a zero duration never expires. Giving the same kind again replaces it.
+// Online players are not kicked or muted by this, see the admin API for that.
+func (h *Hub) Restrict(username string, kind string, reason string, duration time.Duration) (*objects.Restriction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	user, err := h.queries.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("user %s not found: %w", username, err)
+	}
+
+	now := time.Now()
+	restriction := &objects.Restriction{Kind: kind, Reason: reason}
+	expiresAt := sql.NullInt64{}
+	if duration > 0 {
+		restriction.ExpiresAt = now.Add(duration)
+		expiresAt = sql.NullInt64{Int64: restriction.ExpiresAt.Unix(), Valid: true}
+	}
+
+	err = h.queries.SetAccountRestriction(ctx, db.SetAccountRestrictionParams{
+		UserID:    user.ID,
+		Kind:      kind,
+		Reason:    reason,
+		ExpiresAt: expiresAt,
+		CreatedAt: now.Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("saving %s: %w", kind, err)
+	}
+
+	return restriction, nil
+}
+
+// Removes a ban or a mute from an account, it's not an error if there was none
+func (h *Hub) LiftRestriction(username string, kind string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	user, err := h.queries.GetUserByUsername(ctx, username)
+	if err != nil {
+		return fmt.Errorf("user %s not found: %w", username, err)
+	}
+
+	err = h.queries.DeleteAccountRestriction(ctx, db.DeleteAccountRestrictionParams{UserID: user.ID, Kind: kind})
+	if err != nil {
+		return fmt.Errorf("removing %s: %w", kind, err)
+	}
+	return nil
+}
+
+// Returns the active ban or mute of an account, nil if it has none or it already expired
+func (h *Hub) GetRestriction(userId int64, kind string) (*objects.Restriction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	row, err := h.queries.GetAccountRestriction(ctx, db.GetAccountRestrictionParams{UserID: userId, Kind: kind})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading %s: %w", kind, err)
+	}
+
+	restriction := &objects.Restriction{Kind: kind, Reason: row.Reason}
+	if row.ExpiresAt.Valid {
+		restriction.ExpiresAt = time.Unix(row.ExpiresAt.Int64, 0)
+	}
+
+	// Expired restrictions stay in the database until they are given again or lifted
+	if !restriction.IsActive(time.Now()) {
+		return nil, nil
+	}
+	return restriction, nil
+}
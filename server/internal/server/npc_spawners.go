@@ -0,0 +1,169 @@
+package server
+
+import (
+	"math/rand"
+	"server/internal/server/objects"
+	"server/internal/server/pathfinding"
+	"time"
+)
+
+const npcSpawnerRange uint64 = 15                         // Spawners sleep when no player is closer than this to their area
+const npcSpawnerCheckInterval time.Duration = time.Second // Time between checks for nearby players
+const npcSpawnAttempts int = 10                           // Random cells tried before falling back to the nearest free cell
+const npcPatrolPause time.Duration = 3 * time.Second      // Time NPCs wait at each patrol point
+
+// Keeps a number of NPCs alive in an area of a region, bringing them back some time after they die
+type NpcSpawner struct {
+	Template     string
+	Area         []*pathfinding.Cell // Cells where NPCs can appear, NPCs without a route wander within it
+	MaxAlive     int
+	RespawnDelay time.Duration
+	PatrolRoute  []*pathfinding.Cell // Cells walked in order by the spawned NPCs (empty to wander)
+
+	alive       map[uint64]*objects.NPC // NPCs spawned by us that are still alive, keyed by NPC ID
+	respawnAt   []time.Time             // When each dead NPC comes back, oldest first
+	active      bool                    // True while there are players nearby
+	nextCheckAt time.Time
+}
+
+// Runs every spawner of this region, called every tick by the hub
+func (r *Region) updateNpcSpawners(now time.Time) {
+	for _, spawner := range r.NpcSpawners {
+		r.updateNpcSpawner(spawner, now)
+	}
+}
+
+func (r *Region) updateNpcSpawner(spawner *NpcSpawner, now time.Time) {
+	if spawner.alive == nil {
+		spawner.alive = make(map[uint64]*objects.NPC)
+	}
+
+	// Forget the NPCs that died, they come back after the delay
+	for id := range spawner.alive {
+		if _, exists := r.NPCs.Get(id); !exists {
+			delete(spawner.alive, id)
+			spawner.respawnAt = append(spawner.respawnAt, now.Add(spawner.RespawnDelay))
+		}
+	}
+
+	// Looking for players is more expensive than the rest, so we don't do it every tick
+	if !now.Before(spawner.nextCheckAt) {
+		spawner.nextCheckAt = now.Add(npcSpawnerCheckInterval)
+
+		active := r.isPlayerNearCells(spawner.Area, npcSpawnerRange)
+		if active != spawner.active {
+			spawner.active = active
+			// Our NPCs stop thinking and moving while nobody is around to see them
+			for _, npc := range spawner.alive {
+				npc.Dormant = !active
+			}
+		}
+	}
+
+	if !spawner.active {
+		return
+	}
+
+	// Fill the free spots whose respawn delay is over (new spawners start full)
+	for len(spawner.alive) < spawner.MaxAlive {
+		if len(spawner.respawnAt) > 0 {
+			if now.Before(spawner.respawnAt[0]) {
+				break
+			}
+			spawner.respawnAt = spawner.respawnAt[1:]
+		}
+
+		npc := r.spawnFromSpawner(spawner)
+		if npc == nil {
+			break
+		}
+		spawner.alive[npc.Id] = npc
+	}
+}
+
+// Spawns one NPC on a random free cell of the spawner's area
+func (r *Region) spawnFromSpawner(spawner *NpcSpawner) *objects.NPC {
+	grid := r.GetGrid()
+	if len(spawner.Area) == 0 {
+		return nil
+	}
+
+	// If every cell we try is taken, SpawnNPC uses the nearest free one
+	spawnCell := spawner.Area[0]
+	for range npcSpawnAttempts {
+		candidate := spawner.Area[rand.Intn(len(spawner.Area))]
+		if grid.GetValidCell(candidate.X, candidate.Z) != nil {
+			spawnCell = candidate
+			break
+		}
+	}
+
+	npc, err := r.SpawnNPC(spawner.Template, spawnCell, objects.SOUTH)
+	if err != nil {
+		r.logger.Warn("Spawner failed to spawn NPC", "template", spawner.Template, "error", err)
+		return nil
+	}
+
+	// The config cells are not the grid's cells, so we look them up
+	for _, cell := range spawner.PatrolRoute {
+		npc.PatrolRoute = append(npc.PatrolRoute, grid.LocalToMap(cell.X, cell.Z))
+	}
+	// NPCs without a route wander within the area
+	if len(npc.PatrolRoute) == 0 {
+		for _, cell := range spawner.Area {
+			npc.WanderArea = append(npc.WanderArea, grid.LocalToMap(cell.X, cell.Z))
+		}
+	}
+	return npc
+}
+
+// Returns true if any living player is within range of any of the cells
+func (r *Region) isPlayerNearCells(cells []*pathfinding.Cell, maxDistance uint64) bool {
+	found := false
+	r.Clients.ForEachWithBreak(func(id uint64, client Client) bool {
+		player := client.GetPlayerCharacter()
+		if player == nil || !player.IsAlive() || player.GetGridPosition() == nil {
+			return false
+		}
+		for _, cell := range cells {
+			if objects.CellDistance(cell, player.GetGridPosition()) <= maxDistance {
+				found = true
+				break
+			}
+		}
+		// Stop looking as soon as we find one
+		return found
+	})
+	return found
+}
+
+// Moves the post of an idle NPC to its next patrol point after it waited at the current one
+func (r *Region) updateNpcPatrol(npc *objects.NPC, now time.Time) {
+	if !npc.HasPatrol() {
+		return
+	}
+
+	// Someone is standing on our patrol point, skip it
+	if npc.Post.Object != nil && npc.Post.Object != npc {
+		npc.Post = npc.NextPatrolCell()
+		npc.PatrolWaitUntil = time.Time{}
+		return
+	}
+
+	if npc.GetGridPosition() != npc.Post {
+		return
+	}
+
+	// We just arrived, wait here for a bit
+	if npc.PatrolWaitUntil.IsZero() {
+		npc.PatrolWaitUntil = now.Add(npcPatrolPause)
+		return
+	}
+
+	if now.Before(npc.PatrolWaitUntil) {
+		return
+	}
+
+	npc.Post = npc.NextPatrolCell()
+	npc.PatrolWaitUntil = time.Time{}
+}
@@ -0,0 +1,428 @@
+package server
+
+import (
+	"fmt"
+	"math/rand"
+	"server/internal/server/ai"
+	"server/internal/server/logging"
+	"server/internal/server/objects"
+	"server/internal/server/pathfinding"
+	"server/pkg/packets"
+	"sort"
+	"time"
+)
+
+const npcThinkInterval time.Duration = 500 * time.Millisecond // Time between plans, thinking every tick is too expensive
+const npcUnderFireWindow time.Duration = time.Second          // Time an NPC feels under fire after being hit
+const npcBlockDuration time.Duration = time.Second            // Time a block halves incoming damage
+const npcLowHealthPercent uint64 = 40                         // Below this health NPCs look for cover
+const npcCriticalChance float64 = 0.1                         // Chance of each NPC hit being a headshot
+const npcBlindAccuracy float64 = 0.5                          // Accuracy multiplier when shooting without looking
+const npcCoverSearchRadius int = 3                            // Max distance in cells to look for cover
+
+// NPC placed in a region when it is created
+type NpcSpawn struct {
+	Template string
+	Position *pathfinding.Cell
+	Rotation float64
+}
+
+// Places an NPC on the nearest free cell and tells everyone in the region about it
+func (r *Region) SpawnNPC(template string, cell *pathfinding.Cell, rotation float64) (*objects.NPC, error) {
+	npc, exists := objects.CreateNPC(template, rotation)
+	if !exists {
+		return nil, fmt.Errorf("unknown NPC template %s", template)
+	}
+
+	grid := r.GetGrid()
+	spawnCell := grid.GetSpawnCell(cell.X, cell.Z)
+	if spawnCell == nil {
+		return nil, fmt.Errorf("no spawn location available in region %d", r.GetId())
+	}
+
+	grid.SetObject(spawnCell, npc)
+	npc.SetGridDestination(spawnCell)
+	// NPCs guard the cell where they spawned
+	npc.Post = spawnCell
+
+	r.NPCs.Add(npc, npc.Id)
+	r.SendToAll(packets.NewSpawnNpc(npc))
+
+	r.logger.Debug("NPC spawned", "npc", npc.Name, "npc_id", npc.Id, "x", spawnCell.X, "z", spawnCell.Z)
+	return npc, nil
+}
+
+// Spawns the NPCs defined in this region's config
+func (r *Region) SpawnNPCs() {
+	for _, spawn := range r.NpcSpawns {
+		if _, err := r.SpawnNPC(spawn.Template, spawn.Position, spawn.Rotation); err != nil {
+			r.logger.Warn("Failed to spawn NPC", "template", spawn.Template, "error", err)
+		}
+	}
+}
+
+// Removes an NPC from the grid and the region without killing it
+func (r *Region) RemoveNPC(npc *objects.NPC) {
+	r.freeNpcCell(npc)
+	r.NPCs.Remove(npc.Id)
+	r.SendToAll(packets.NewDespawnNpc(npc.Id))
+}
+
+// Handles an NPC whose health reached zero, the killer can loot its drops for a while
+// The weapon and whether the killing blow was critical are shown in the kill feed
+func (r *Region) KillNPC(npc *objects.NPC, attackerId uint64, killerCharacterId int64, weaponName string, isCritical bool) {
+	cell := npc.GetGridPosition()
+	r.freeNpcCell(npc)
+	r.NPCs.Remove(npc.Id)
+	r.SendToAll(packets.NewNpcDied(npc.Id, attackerId))
+
+	if attacker, exists := r.GetClient(attackerId); exists {
+		attacker.GetPlayerCharacter().GetUnsavedStats().RecordKill(weaponName, true)
+		r.GiveExperience(attacker, objects.EXPERIENCE_PER_NPC_KILL)
+	}
+	r.SendToAll(packets.NewKillFeed(attackerId, r.getAttackerName(attackerId), npc.Id, npc.Name, weaponName, isCritical))
+
+	// Roll every possible drop
+	for _, drop := range npc.GetStats().Drops {
+		if rand.Float64() < drop.Chance {
+			r.DropItem(&objects.InventoryItem{ItemName: drop.ItemName, Quantity: drop.Quantity}, cell, killerCharacterId)
+		}
+	}
+
+	r.logger.Debug("NPC killed", "npc", npc.Name, "npc_id", npc.Id, logging.ClientId, attackerId)
+}
+
+// Frees the cell the NPC is standing on, unless someone else took it
+func (r *Region) freeNpcCell(npc *objects.NPC) {
+	cell := npc.GetGridPosition()
+	if cell != nil && cell.Object == npc {
+		grid := r.GetGrid()
+		grid.SetObject(cell, nil)
+	}
+}
+
+// Sends every NPC to a client that just joined this region
+func (r *Region) SendNPCs(client Client) {
+	r.NPCs.ForEach(func(id uint64, npc *objects.NPC) {
+		client.SendPacket(packets.NewSpawnNpc(npc))
+	})
+}
+
+// Makes every NPC think, act and move, called every tick by the hub
+func (r *Region) updateNPCs(now time.Time) {
+	// Nobody is here to see them
+	if r.Clients.Len() == 0 {
+		return
+	}
+
+	r.NPCs.ForEach(func(id uint64, npc *objects.NPC) {
+		if !npc.IsAlive() || npc.Dormant {
+			return
+		}
+
+		if !now.Before(npc.NextThinkAt) {
+			r.thinkNPC(npc, now)
+			npc.NextThinkAt = now.Add(npcThinkInterval)
+		}
+
+		r.actNPC(npc, now)
+		r.moveNPC(npc, now)
+	})
+}
+
+// Senses the world and makes a plan for the most urgent goal that can be achieved
+func (r *Region) thinkNPC(npc *objects.NPC, now time.Time) {
+	stats := npc.GetStats()
+	state := r.senseNPC(npc, now)
+
+	// Patrolling NPCs move their post around while they have nothing to fight
+	if !state[ai.FACT_HAS_TARGET] {
+		r.updateNpcPatrol(npc, now)
+		state[ai.FACT_AT_POST] = npc.GetGridPosition() == npc.Post
+	}
+
+	// Sort our goals by how urgent they are right now
+	goals := make([]string, 0, len(stats.Goals))
+	for _, goal := range stats.Goals {
+		if ai.GetGoalPriority(goal, state) > 0 {
+			goals = append(goals, goal)
+		}
+	}
+	sort.SliceStable(goals, func(i, j int) bool {
+		return ai.GetGoalPriority(goals[i], state) > ai.GetGoalPriority(goals[j], state)
+	})
+
+	actions := ai.GetActions(stats.Actions)
+	for _, goal := range goals {
+		plan := ai.Plan(state, ai.Goals[goal], actions)
+		// If we can't reach this goal, try the next one
+		if plan == nil {
+			continue
+		}
+		npc.Goal = goal
+		npc.Plan = plan
+		return
+	}
+
+	// Nothing to do
+	npc.Goal = ""
+	npc.Plan = nil
+}
+
+// Builds the world state as this NPC sees it
+func (r *Region) senseNPC(npc *objects.NPC, now time.Time) ai.WorldState {
+	stats := npc.GetStats()
+	state := ai.WorldState{
+		ai.FACT_AT_POST:    npc.GetGridPosition() == npc.Post,
+		ai.FACT_LOW_HEALTH: npc.GetHealth()*100 < npc.GetMaxHealth()*npcLowHealthPercent,
+		ai.FACT_UNDER_FIRE: now.Sub(npc.LastDamagedAt) < npcUnderFireWindow,
+	}
+
+	target := r.findNpcTarget(npc)
+	if target == nil {
+		// Nobody to hide from
+		npc.CoverCell = nil
+		return state
+	}
+
+	targetCell := target.GetPlayerCharacter().GetGridPosition()
+	state[ai.FACT_HAS_TARGET] = true
+	state[ai.FACT_TARGET_IN_RANGE] = objects.CellDistance(npc.GetGridPosition(), targetCell) <= stats.AttackRange
+	state[ai.FACT_IN_COVER] = r.isNpcInCover(npc, targetCell)
+	return state
+}
+
+// Keeps the current target while it's alive and in sight, otherwise picks the closest player in sight
+func (r *Region) findNpcTarget(npc *objects.NPC) Client {
+	stats := npc.GetStats()
+	position := npc.GetGridPosition()
+
+	if target := r.getNpcTarget(npc); target != nil {
+		if objects.CellDistance(position, target.GetPlayerCharacter().GetGridPosition()) <= stats.SightRange {
+			return target
+		}
+	}
+
+	var closest Client = nil
+	var closestDistance uint64 = 0
+	r.Clients.ForEach(func(id uint64, client Client) {
+		player := client.GetPlayerCharacter()
+		if player == nil || !player.IsAlive() || player.GetGridPosition() == nil {
+			return
+		}
+		distance := objects.CellDistance(position, player.GetGridPosition())
+		if distance > stats.SightRange {
+			return
+		}
+		if closest == nil || distance < closestDistance {
+			closest = client
+			closestDistance = distance
+		}
+	})
+
+	if closest == nil {
+		npc.TargetId = 0
+		return nil
+	}
+	npc.TargetId = closest.GetId()
+	return closest
+}
+
+// Returns the client this NPC is fighting if it's still in the region and alive
+func (r *Region) getNpcTarget(npc *objects.NPC) Client {
+	if npc.TargetId == 0 {
+		return nil
+	}
+	target, exists := r.GetClient(npc.TargetId)
+	if !exists {
+		return nil
+	}
+	player := target.GetPlayerCharacter()
+	if player == nil || !player.IsAlive() || player.GetGridPosition() == nil {
+		return nil
+	}
+	return target
+}
+
+// Returns true if the obstacles around the NPC protect it from the threat
+func (r *Region) isNpcInCover(npc *objects.NPC, threat *pathfinding.Cell) bool {
+	grid := r.GetGrid()
+	return grid.GetCoverFrom(npc.GetGridPosition(), threat) > pathfinding.COVER_NONE
+}
+
+// Picks the nearest cell with cover from the threat that is still within attack range
+func (r *Region) findCoverCell(npc *objects.NPC, threat *pathfinding.Cell) *pathfinding.Cell {
+	grid := r.GetGrid()
+	cover := grid.NearestCover(npc.GetGridPosition(), threat, npcCoverSearchRadius, npc)
+	if cover == nil || objects.CellDistance(cover, threat) > npc.GetStats().AttackRange {
+		return nil
+	}
+	return cover
+}
+
+// Performs the first action of the NPC's plan
+func (r *Region) actNPC(npc *objects.NPC, now time.Time) {
+	if len(npc.Plan) == 0 {
+		return
+	}
+
+	stats := npc.GetStats()
+	target := r.getNpcTarget(npc)
+	done := false
+
+	switch npc.Plan[0].Name {
+	case ai.ACTION_IDLE:
+		// Wait crouched until the target comes closer
+		r.setNpcCrouching(npc, true)
+
+	case ai.ACTION_DODGE:
+		// Sidestep into a random free cell
+		grid := r.GetGrid()
+		var freeCells []*pathfinding.Cell
+		for _, cell := range grid.GetNeighbors(npc.GetGridPosition(), 1) {
+			if grid.IsCellAvailable(cell) {
+				freeCells = append(freeCells, cell)
+			}
+		}
+		if len(freeCells) > 0 {
+			npc.SetGridDestination(freeCells[rand.Intn(len(freeCells))])
+		}
+		npc.LastDamagedAt = time.Time{}
+		done = true
+
+	case ai.ACTION_DODGE_COVERED:
+		r.setNpcCrouching(npc, true)
+		npc.LastDamagedAt = time.Time{}
+		done = true
+
+	case ai.ACTION_BLOCK:
+		npc.BlockUntil = now.Add(npcBlockDuration)
+		npc.LastDamagedAt = time.Time{}
+		done = true
+
+	case ai.ACTION_MOVE_TO_POST:
+		r.setNpcCrouching(npc, false)
+		npc.SetGridDestination(npc.Post)
+		done = npc.GetGridPosition() == npc.Post
+
+	case ai.ACTION_MOVE_TO_TARGET:
+		if target == nil {
+			break
+		}
+		r.setNpcCrouching(npc, false)
+		npc.CoverCell = nil
+		targetCell := target.GetPlayerCharacter().GetGridPosition()
+		if objects.CellDistance(npc.GetGridPosition(), targetCell) <= stats.AttackRange {
+			npc.SetGridDestination(npc.GetGridPosition())
+			done = true
+		} else {
+			npc.SetGridDestination(targetCell)
+		}
+
+	case ai.ACTION_MOVE_TO_COVER:
+		if target == nil {
+			break
+		}
+		// Look for new cover if we have none or the target moved around the one we picked
+		threat := target.GetPlayerCharacter().GetGridPosition()
+		grid := r.GetGrid()
+		if npc.CoverCell == nil || grid.GetCoverFrom(npc.CoverCell, threat) == pathfinding.COVER_NONE {
+			npc.CoverCell = r.findCoverCell(npc, threat)
+		}
+		// No cover around, give up on this step
+		if npc.CoverCell == nil {
+			done = true
+			break
+		}
+		npc.SetGridDestination(npc.CoverCell)
+		if npc.GetGridPosition() == npc.CoverCell {
+			r.setNpcCrouching(npc, true)
+			done = true
+		}
+
+	case ai.ACTION_ATTACK_RANGED, ai.ACTION_ATTACK_MELEE:
+		if target != nil {
+			r.setNpcCrouching(npc, false)
+			r.npcAttack(npc, target, stats.Accuracy, now)
+		}
+
+	case ai.ACTION_ATTACK_RANGED_FROM_COVER:
+		if target != nil {
+			r.npcAttack(npc, target, stats.Accuracy, now)
+		}
+
+	case ai.ACTION_BLIND_ATTACK_RANGED_FROM_COVER:
+		if target != nil {
+			r.npcAttack(npc, target, stats.Accuracy*npcBlindAccuracy, now)
+		}
+	}
+
+	if done {
+		npc.Plan = npc.Plan[1:]
+	}
+}
+
+// Attacks the target if the weapon is ready, using the same damage pipeline as players
+func (r *Region) npcAttack(npc *objects.NPC, target Client, accuracy float64, now time.Time) {
+	if now.Before(npc.NextFireAt) {
+		return
+	}
+
+	stats := npc.GetStats()
+	targetCell := target.GetPlayerCharacter().GetGridPosition()
+	if objects.CellDistance(npc.GetGridPosition(), targetCell) > stats.AttackRange {
+		return
+	}
+
+	// Stand still while attacking
+	npc.SetGridDestination(npc.GetGridPosition())
+	npc.NextFireAt = now.Add(stats.FireInterval)
+	npc.LookAt(targetCell)
+
+	hit := rand.Float64() < accuracy
+	r.SendToAll(packets.NewNpcFire(npc.Id, target.GetId(), hit))
+
+	if hit {
+		// Nobody owns the items of players killed by NPCs
+		r.DamagePlayer(target, npc.Id, 0, npc.GetGridPosition(), stats.WeaponName, []bool{rand.Float64() < npcCriticalChance})
+	}
+}
+
+// Steps the NPC one cell closer to its destination when its move interval allows it
+func (r *Region) moveNPC(npc *objects.NPC, now time.Time) {
+	position := npc.GetGridPosition()
+	destination := npc.GetGridDestination()
+	if position == destination || now.Before(npc.NextMoveAt) {
+		return
+	}
+
+	grid := r.GetGrid()
+	path := grid.AStar(position, destination, npc)
+
+	// If the destination can't be reached, forget about it
+	if len(path) < 2 {
+		npc.SetGridDestination(position)
+		return
+	}
+
+	// Someone is standing in the way (usually our target), wait here
+	nextCell := path[1]
+	if !grid.IsCellAvailable(nextCell) {
+		return
+	}
+
+	npc.LookAt(nextCell)
+	grid.SetObject(nextCell, npc)
+	npc.NextMoveAt = now.Add(npc.GetStats().MoveInterval)
+
+	r.SendToAll(packets.NewMoveNpc(npc))
+}
+
+// Changes the NPC's stance and tells everyone, does nothing if it didn't change
+func (r *Region) setNpcCrouching(npc *objects.NPC, crouching bool) {
+	if npc.IsCrouching() == crouching {
+		return
+	}
+	npc.SetCrouching(crouching)
+	r.SendToAll(packets.NewMoveNpc(npc))
+}
@@ -0,0 +1,64 @@
+package objects
+
+// Armor slots
+const (
+	ARMOR_SLOT_HELMET uint64 = 0 // Protects against headshots
+	ARMOR_SLOT_VEST   uint64 = 1 // Protects against every other hit
+	MAX_ARMOR_SLOTS   uint64 = 2
+)
+
+// Damage types
+const (
+	DAMAGE_TYPE_BULLET    string = "bullet"
+	DAMAGE_TYPE_EXPLOSIVE string = "explosive"
+	DAMAGE_TYPE_FIRE      string = "fire"
+	DAMAGE_TYPE_POISON    string = "poison"
+	DAMAGE_TYPE_RADIATION string = "radiation"
+)
+
+// Returns the durability left in this armor piece
+func GetArmorDurability(piece *InventoryItem) uint64 {
+	stats, exists := GetItemStats(piece.ItemName)
+	if !exists || piece.Wear >= stats.MaxDurability {
+		return 0
+	}
+	return stats.MaxDurability - piece.Wear
+}
+
+// Armor get/set, empty slots are nil
+func (player *Player) GetArmor(slot uint64) *InventoryItem {
+	if slot < MAX_ARMOR_SLOTS {
+		return player.armor[slot]
+	}
+	return nil
+}
+func (player *Player) SetArmor(slot uint64, piece *InventoryItem) {
+	if slot < MAX_ARMOR_SLOTS {
+		player.armor[slot] = piece
+	}
+}
+
+// Reduces a hit with the armor piece covering that part of the body, wearing it down
+// Headshots hit the helmet, everything else hits the vest
+// Returns the damage that goes through, the damage absorbed and the armor slot that was hit
+func (player *Player) MitigateDamage(damage uint64, damageType string, isHeadshot bool) (uint64, uint64, uint64) {
+	slot := ARMOR_SLOT_VEST
+	if isHeadshot {
+		slot = ARMOR_SLOT_HELMET
+	}
+
+	piece := player.GetArmor(slot)
+	if piece == nil || GetArmorDurability(piece) == 0 {
+		return damage, 0, slot
+	}
+
+	stats, _ := GetItemStats(piece.ItemName)
+	absorbed := uint64(float64(damage) * stats.Resistances[damageType])
+	// A piece can't absorb more than the durability it has left
+	absorbed = min(absorbed, GetArmorDurability(piece))
+
+	// Every absorbed point of damage is a point of durability lost
+	piece.Wear += absorbed
+
+	return damage - absorbed, absorbed, slot
+}
@@ -0,0 +1,92 @@
+package objects
+
+// Usage of a single weapon
+type WeaponUsage struct {
+	Kills       uint64 // Players and NPCs
+	DamageDealt uint64
+	ShotsFired  uint64
+	Hits        uint64
+	Headshots   uint64
+}
+
+// Combat record of a character, players keep the part that wasn't saved to the database yet
+type CombatStats struct {
+	Kills       uint64 // Other players killed
+	NpcKills    uint64
+	Deaths      uint64
+	DamageDealt uint64
+	DamageTaken uint64
+	ShotsFired  uint64
+	Hits        uint64
+	Headshots   uint64
+	Weapons     map[string]*WeaponUsage // Weapon name to its usage
+}
+
+func CreateCombatStats() *CombatStats {
+	return &CombatStats{Weapons: make(map[string]*WeaponUsage)}
+}
+
+// Returns the usage of a weapon, creating it the first time it's used
+func (stats *CombatStats) GetWeapon(weaponName string) *WeaponUsage {
+	usage, exists := stats.Weapons[weaponName]
+	if !exists {
+		usage = &WeaponUsage{}
+		stats.Weapons[weaponName] = usage
+	}
+	return usage
+}
+
+// Counts a shot of a weapon, hit or not
+func (stats *CombatStats) RecordShot(weaponName string) {
+	stats.ShotsFired++
+	stats.GetWeapon(weaponName).ShotsFired++
+}
+
+// Counts the hits a weapon landed and the damage they did
+func (stats *CombatStats) RecordHits(weaponName string, hits, headshots, damage uint64) {
+	stats.Hits += hits
+	stats.Headshots += headshots
+	stats.DamageDealt += damage
+
+	usage := stats.GetWeapon(weaponName)
+	usage.Hits += hits
+	usage.Headshots += headshots
+	usage.DamageDealt += damage
+}
+
+// Counts a kill made with a weapon
+func (stats *CombatStats) RecordKill(weaponName string, isNpc bool) {
+	if isNpc {
+		stats.NpcKills++
+	} else {
+		stats.Kills++
+	}
+	stats.GetWeapon(weaponName).Kills++
+}
+
+// Adds another record to this one
+func (stats *CombatStats) Add(other *CombatStats) {
+	stats.Kills += other.Kills
+	stats.NpcKills += other.NpcKills
+	stats.Deaths += other.Deaths
+	stats.DamageDealt += other.DamageDealt
+	stats.DamageTaken += other.DamageTaken
+	stats.ShotsFired += other.ShotsFired
+	stats.Hits += other.Hits
+	stats.Headshots += other.Headshots
+
+	for weaponName, otherUsage := range other.Weapons {
+		usage := stats.GetWeapon(weaponName)
+		usage.Kills += otherUsage.Kills
+		usage.DamageDealt += otherUsage.DamageDealt
+		usage.ShotsFired += otherUsage.ShotsFired
+		usage.Hits += otherUsage.Hits
+		usage.Headshots += otherUsage.Headshots
+	}
+}
+
+// Returns true if nothing was recorded
+func (stats *CombatStats) IsEmpty() bool {
+	return stats.ShotsFired == 0 && stats.Hits == 0 && stats.Deaths == 0 && stats.DamageTaken == 0 &&
+		stats.Kills == 0 && stats.NpcKills == 0 && len(stats.Weapons) == 0
+}
@@ -0,0 +1,41 @@
+package objects
+
+// Experience rewards
+const (
+	EXPERIENCE_PER_KILL      uint64 = 100 // Killing another player
+	EXPERIENCE_PER_NPC_KILL  uint64 = 25
+	EXPERIENCE_PER_MATCH_WIN uint64 = 500 // Alone or with the team
+)
+
+const MAX_LEVEL uint64 = 100
+
+// Every level takes 1000 more experience than the previous one: level 2 at 1000, level 3 at 3000, level 4 at 6000...
+const EXPERIENCE_LEVEL_STEP uint64 = 1000
+
+// Total experience needed to reach a level, level 1 needs none
+func ExperienceForLevel(level uint64) uint64 {
+	if level <= 1 {
+		return 0
+	}
+	return EXPERIENCE_LEVEL_STEP * level * (level - 1) / 2
+}
+
+// Level reached with this much experience
+func LevelForExperience(experience uint64) uint64 {
+	level := uint64(1)
+	for level < MAX_LEVEL && experience >= ExperienceForLevel(level+1) {
+		level++
+	}
+	return level
+}
+
+// Adds experience to the player, returns true if it reached a new level
+func (player *Player) AddExperience(amount uint64) bool {
+	player.Experience += amount
+	level := LevelForExperience(player.Experience)
+	if level <= player.Level {
+		return false
+	}
+	player.Level = level
+	return true
+}
@@ -0,0 +1,34 @@
+package objects
+
+import (
+	"server/internal/server/pathfinding"
+	"time"
+)
+
+// Stack of items lying on a grid cell, it doesn't block movement
+type GroundItem struct {
+	Id       uint64 // Assigned by the region
+	ItemName string
+	Quantity uint64
+	Wear     uint64 // Durability lost, only used by items that don't stack (armor)
+	Ammo     uint64 // Bullets kept in a stored weapon, only used by weapons
+	Position *pathfinding.Cell
+	// Only this character can pick the item up until the ownership expires (0 means anyone)
+	OwnerCharacterId int64
+	OwnerUntil       time.Time
+	DespawnAt        time.Time
+	FromLootTable    bool // Spawned by the region's loot table
+}
+
+// Returns true if this character is allowed to pick the item up right now
+func (item *GroundItem) CanBePickedUpBy(characterId int64, now time.Time) bool {
+	if item.OwnerCharacterId == 0 || item.OwnerCharacterId == characterId {
+		return true
+	}
+	return now.After(item.OwnerUntil)
+}
+
+// Returns true once the item has been on the ground for too long
+func (item *GroundItem) IsExpired(now time.Time) bool {
+	return now.After(item.DespawnAt)
+}
@@ -0,0 +1,89 @@
+package objects
+
+const (
+	MAX_GUILD_RANKS   uint64 = 5
+	GUILD_LEADER      uint64 = 0 // Rank index of the guild leader
+	MAX_GUILD_MEMBERS uint64 = 100
+)
+
+// Guild permissions, stored as a bitmask in every rank
+const (
+	GUILD_PERMISSION_CHAT       uint64 = 1 << iota // Can talk in the guild chat
+	GUILD_PERMISSION_INVITE                        // Can invite new members
+	GUILD_PERMISSION_KICK                          // Can kick members with a lower rank
+	GUILD_PERMISSION_PROMOTE                       // Can change the rank of members with a lower rank
+	GUILD_PERMISSION_EDIT_RANKS                    // Can rename ranks and change their permissions
+	GUILD_PERMISSION_DISBAND                       // Can disband the guild
+	GUILD_PERMISSION_ALL        uint64 = 1<<iota - 1
+)
+
+type GuildRank struct {
+	Name        string
+	Permissions uint64
+}
+
+type GuildMember struct {
+	Nickname string
+	Rank     uint64 // Index in the guild ranks, lower is better
+}
+
+// Player-created organization, kept in memory while any of its members is online
+type Guild struct {
+	Id       uint64
+	Name     string
+	Tag      string // Short tag displayed next to the member's nickname
+	LeaderId int64  // Character ID of the leader
+	Ranks    []*GuildRank
+	Members  map[int64]*GuildMember // Keyed by character ID
+}
+
+// Ranks every new guild starts with
+func DefaultGuildRanks() []*GuildRank {
+	return []*GuildRank{
+		{Name: "Leader", Permissions: GUILD_PERMISSION_ALL},
+		{Name: "Officer", Permissions: GUILD_PERMISSION_CHAT | GUILD_PERMISSION_INVITE | GUILD_PERMISSION_KICK | GUILD_PERMISSION_PROMOTE},
+		{Name: "Veteran", Permissions: GUILD_PERMISSION_CHAT | GUILD_PERMISSION_INVITE},
+		{Name: "Member", Permissions: GUILD_PERMISSION_CHAT},
+		{Name: "Recruit", Permissions: GUILD_PERMISSION_CHAT},
+	}
+}
+
+// Returns the rank index new members join with
+func (guild *Guild) GetLowestRank() uint64 {
+	return uint64(len(guild.Ranks) - 1)
+}
+
+// Returns the member (if found) by character ID
+func (guild *Guild) GetMember(characterId int64) (*GuildMember, bool) {
+	member, found := guild.Members[characterId]
+	return member, found
+}
+
+// Returns the character ID of the member with that nickname, if found
+func (guild *Guild) GetMemberIdByNickname(nickname string) (int64, bool) {
+	for characterId, member := range guild.Members {
+		if member.Nickname == nickname {
+			return characterId, true
+		}
+	}
+	return 0, false
+}
+
+// Checks if this member's rank grants the permission
+func (guild *Guild) HasPermission(characterId int64, permission uint64) bool {
+	member, found := guild.Members[characterId]
+	if !found || member.Rank >= uint64(len(guild.Ranks)) {
+		return false
+	}
+	return guild.Ranks[member.Rank].Permissions&permission != 0
+}
+
+// Checks if the first member outranks the second one (lower index is a higher rank)
+func (guild *Guild) Outranks(characterId int64, otherId int64) bool {
+	member, found := guild.Members[characterId]
+	other, otherFound := guild.Members[otherId]
+	if !found || !otherFound {
+		return false
+	}
+	return member.Rank < other.Rank
+}
@@ -0,0 +1,71 @@
+package objects
+
+import (
+	"server/internal/server/pathfinding"
+	"slices"
+	"time"
+)
+
+// Hazard types
+const (
+	HAZARD_TYPE_GAS       string = "gas"
+	HAZARD_TYPE_RADIATION string = "radiation"
+	HAZARD_TYPE_FIRE      string = "fire"
+)
+
+// Area of a region that hurts the players standing in it
+type HazardZone struct {
+	Name         string
+	Type         string // HAZARD_TYPE_GAS, HAZARD_TYPE_RADIATION or HAZARD_TYPE_FIRE
+	Cells        []*pathfinding.Cell
+	Interval     time.Duration // Time between ticks
+	Damage       uint64        // Damage per tick
+	DamageType   string        // Armor resistances to this damage type still apply
+	StatusEffect string        // Status effect applied every tick (empty if none)
+
+	cellSet    map[[2]uint64]bool // Fast lookup of the cells
+	nextTickAt time.Time
+}
+
+// Returns true if this cell is inside the hazard
+func (zone *HazardZone) Contains(cell *pathfinding.Cell) bool {
+	if zone.cellSet == nil {
+		zone.cellSet = make(map[[2]uint64]bool, len(zone.Cells))
+		for _, zoneCell := range zone.Cells {
+			zone.cellSet[[2]uint64{zoneCell.X, zoneCell.Z}] = true
+		}
+	}
+	return cell != nil && zone.cellSet[[2]uint64{cell.X, cell.Z}]
+}
+
+// Returns true once it's time for the next tick, and schedules the one after it
+func (zone *HazardZone) IsDue(now time.Time) bool {
+	if now.Before(zone.nextTickAt) {
+		return false
+	}
+	zone.nextTickAt = now.Add(zone.Interval)
+	return true
+}
+
+// Returns true if an item we are carrying or wearing negates this hazard type
+func (player *Player) IsProtectedFrom(hazardType string) bool {
+	protects := func(item *InventoryItem) bool {
+		if item == nil {
+			return false
+		}
+		stats, exists := GetItemStats(item.ItemName)
+		return exists && slices.Contains(stats.Protects, hazardType)
+	}
+
+	for slot := range MAX_ARMOR_SLOTS {
+		if protects(player.GetArmor(slot)) {
+			return true
+		}
+	}
+	for _, item := range player.GetInventory().GetSlots() {
+		if protects(item) {
+			return true
+		}
+	}
+	return false
+}
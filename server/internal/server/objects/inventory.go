@@ -0,0 +1,278 @@
+package objects
+
+import "errors"
+
+const (
+	INVENTORY_CAPACITY   uint64 = 20    // Slots in every character's inventory
+	INVENTORY_MAX_WEIGHT uint64 = 40000 // Grams a character can carry
+)
+
+type InventoryItem struct {
+	ItemName string
+	Quantity uint64
+	Wear     uint64 // Durability lost, only used by items that don't stack (armor)
+	Ammo     uint64 // Bullets kept in a stored weapon, only used by weapons
+}
+
+// General item storage with a fixed number of slots and a weight limit
+type Inventory struct {
+	slots     []*InventoryItem // nil slots are empty
+	maxWeight uint64           // Grams
+}
+
+// Static function to create an empty inventory
+func CreateInventory(capacity uint64, maxWeight uint64) *Inventory {
+	return &Inventory{
+		slots:     make([]*InventoryItem, capacity),
+		maxWeight: maxWeight,
+	}
+}
+
+// Returns the number of slots in this inventory
+func (inventory *Inventory) GetCapacity() uint64 {
+	return uint64(len(inventory.slots))
+}
+
+// Returns the max weight in grams
+func (inventory *Inventory) GetMaxWeight() uint64 {
+	return inventory.maxWeight
+}
+
+// Returns every slot, empty slots are nil
+func (inventory *Inventory) GetSlots() []*InventoryItem {
+	return inventory.slots
+}
+
+// Slot get/set
+func (inventory *Inventory) GetSlot(slot uint64) *InventoryItem {
+	if slot < inventory.GetCapacity() {
+		return inventory.slots[slot]
+	}
+	return nil
+}
+func (inventory *Inventory) SetSlot(slot uint64, item *InventoryItem) {
+	if slot < inventory.GetCapacity() {
+		inventory.slots[slot] = item
+	}
+}
+
+// Returns the total weight of every item in grams
+func (inventory *Inventory) GetWeight() uint64 {
+	var weight uint64 = 0
+	for _, item := range inventory.slots {
+		if item != nil {
+			stats, exists := GetItemStats(item.ItemName)
+			if exists {
+				weight += stats.Weight * item.Quantity
+			}
+		}
+	}
+	return weight
+}
+
+// Returns how many units of this item we are carrying
+func (inventory *Inventory) CountItem(itemName string) uint64 {
+	var count uint64 = 0
+	for _, item := range inventory.slots {
+		if item != nil && item.ItemName == itemName {
+			count += item.Quantity
+		}
+	}
+	return count
+}
+
+// Returns how many units of this item still fit, by weight and by free space
+func (inventory *Inventory) GetRoomFor(itemName string) uint64 {
+	stats, exists := GetItemStats(itemName)
+	if !exists || stats.MaxStack == 0 {
+		return 0
+	}
+
+	// Room left in the stacks we already have plus the empty slots
+	var room uint64 = 0
+	for _, item := range inventory.slots {
+		if item == nil {
+			room += stats.MaxStack
+		} else if item.ItemName == itemName && item.Quantity < stats.MaxStack {
+			room += stats.MaxStack - item.Quantity
+		}
+	}
+
+	// Room left by weight
+	if stats.Weight > 0 {
+		weight := inventory.GetWeight()
+		if weight >= inventory.maxWeight {
+			return 0
+		}
+		room = min(room, (inventory.maxWeight-weight)/stats.Weight)
+	}
+
+	return room
+}
+
+// Adds as many units as fit, filling existing stacks first
+// Returns how many units didn't fit
+func (inventory *Inventory) AddItem(itemName string, quantity uint64) uint64 {
+	stats, exists := GetItemStats(itemName)
+	if !exists {
+		return quantity
+	}
+
+	toAdd := min(quantity, inventory.GetRoomFor(itemName))
+	leftover := quantity - toAdd
+
+	// Fill the stacks we already have
+	for _, item := range inventory.slots {
+		if toAdd == 0 {
+			break
+		}
+		if item != nil && item.ItemName == itemName && item.Quantity < stats.MaxStack {
+			amount := min(stats.MaxStack-item.Quantity, toAdd)
+			item.Quantity += amount
+			toAdd -= amount
+		}
+	}
+
+	// Create new stacks in the empty slots
+	for slot := range inventory.slots {
+		if toAdd == 0 {
+			break
+		}
+		if inventory.slots[slot] == nil {
+			amount := min(stats.MaxStack, toAdd)
+			inventory.slots[slot] = &InventoryItem{ItemName: itemName, Quantity: amount}
+			toAdd -= amount
+		}
+	}
+
+	return leftover
+}
+
+// Places a single used item (like damaged armor) in an empty slot, so its wear is kept
+// Returns false if it doesn't fit
+func (inventory *Inventory) AddWornItem(itemName string, wear uint64) bool {
+	if inventory.GetRoomFor(itemName) == 0 {
+		return false
+	}
+
+	for slot := range inventory.slots {
+		if inventory.slots[slot] == nil {
+			inventory.slots[slot] = &InventoryItem{ItemName: itemName, Quantity: 1, Wear: wear}
+			return true
+		}
+	}
+
+	return false
+}
+
+// Places a single weapon in an empty slot with the bullets it still had, so they aren't lost
+// Returns the new item, or nil if it doesn't fit
+func (inventory *Inventory) AddStoredWeapon(itemName string, ammo uint64) *InventoryItem {
+	if inventory.GetRoomFor(itemName) == 0 {
+		return nil
+	}
+
+	for slot := range inventory.slots {
+		if inventory.slots[slot] == nil {
+			inventory.slots[slot] = &InventoryItem{ItemName: itemName, Quantity: 1, Ammo: ammo}
+			return inventory.slots[slot]
+		}
+	}
+
+	return nil
+}
+
+// Takes units out of a slot, a quantity of 0 takes the whole stack
+// Returns what was removed
+func (inventory *Inventory) RemoveFromSlot(slot uint64, quantity uint64) (*InventoryItem, error) {
+	item := inventory.GetSlot(slot)
+	if item == nil {
+		return nil, errors.New("slot is empty")
+	}
+
+	if quantity == 0 || quantity >= item.Quantity {
+		inventory.slots[slot] = nil
+		return item, nil
+	}
+
+	item.Quantity -= quantity
+	return &InventoryItem{ItemName: item.ItemName, Quantity: quantity}, nil
+}
+
+// Removes units of this item from any slot, starting from the last one
+// Returns false without removing anything if we don't have enough
+func (inventory *Inventory) RemoveItem(itemName string, quantity uint64) bool {
+	if inventory.CountItem(itemName) < quantity {
+		return false
+	}
+
+	for slot := len(inventory.slots) - 1; slot >= 0 && quantity > 0; slot-- {
+		item := inventory.slots[slot]
+		if item == nil || item.ItemName != itemName {
+			continue
+		}
+		amount := min(item.Quantity, quantity)
+		item.Quantity -= amount
+		quantity -= amount
+		if item.Quantity == 0 {
+			inventory.slots[slot] = nil
+		}
+	}
+
+	return true
+}
+
+// Moves units between slots, merging stacks of the same item or swapping different items
+// A quantity of 0 moves the whole stack
+func (inventory *Inventory) MoveItem(fromSlot uint64, toSlot uint64, quantity uint64) error {
+	if fromSlot >= inventory.GetCapacity() || toSlot >= inventory.GetCapacity() {
+		return errors.New("invalid slot")
+	}
+	if fromSlot == toSlot {
+		return errors.New("same slot")
+	}
+
+	from := inventory.slots[fromSlot]
+	if from == nil {
+		return errors.New("slot is empty")
+	}
+	if quantity == 0 || quantity > from.Quantity {
+		quantity = from.Quantity
+	}
+
+	to := inventory.slots[toSlot]
+
+	// Move or split into an empty slot
+	if to == nil {
+		if quantity == from.Quantity {
+			inventory.slots[toSlot] = from
+			inventory.slots[fromSlot] = nil
+		} else {
+			inventory.slots[toSlot] = &InventoryItem{ItemName: from.ItemName, Quantity: quantity}
+			from.Quantity -= quantity
+		}
+		return nil
+	}
+
+	// Merge into a stack of the same item
+	if to.ItemName == from.ItemName {
+		stats, _ := GetItemStats(to.ItemName)
+		if to.Quantity >= stats.MaxStack {
+			return errors.New("stack is full")
+		}
+		amount := min(stats.MaxStack-to.Quantity, quantity)
+		to.Quantity += amount
+		from.Quantity -= amount
+		if from.Quantity == 0 {
+			inventory.slots[fromSlot] = nil
+		}
+		return nil
+	}
+
+	// Different items can only be swapped as a whole
+	if quantity != from.Quantity {
+		return errors.New("can't split into an occupied slot")
+	}
+	inventory.slots[fromSlot], inventory.slots[toSlot] = to, from
+	return nil
+}
@@ -0,0 +1,91 @@
+package objects
+
+import "time"
+
+// Item categories
+const (
+	ITEM_TYPE_CONSUMABLE string = "consumable"
+	ITEM_TYPE_AMMO       string = "ammo"
+	ITEM_TYPE_ARMOR      string = "armor"
+	ITEM_TYPE_KEY        string = "key"
+	ITEM_TYPE_RESOURCE   string = "resource"
+	ITEM_TYPE_WEAPON     string = "weapon"
+	ITEM_TYPE_GEAR       string = "gear" // Protects against hazards while carried
+)
+
+type ItemStats struct {
+	DisplayName string
+	Type        string
+	MaxStack    uint64 // Max quantity per inventory slot
+	Weight      uint64 // Grams per unit
+	// Weapons
+	WeaponType string // Animation set used by the client (rifle, shotgun, etc)
+	// Ammo boxes
+	AmmoType   string // Weapon type this ammo can be loaded into
+	AmmoAmount uint64 // Bullets per box
+	// Usable items (consumables and ammo boxes)
+	HealAmount  uint64        // Health restored when the use finishes
+	UseDuration time.Duration // Time the player has to stand still while using it
+	Cooldown    time.Duration // Time before another item with this name can be used
+	Cures       []string      // Status effects removed when the use finishes
+	// Armor
+	ArmorSlot     uint64             // ARMOR_SLOT_HELMET or ARMOR_SLOT_VEST
+	MaxDurability uint64             // Damage the piece can absorb before it breaks
+	Resistances   map[string]float64 // Damage type to the fraction of damage absorbed (0 to 1)
+	// Protection
+	Protects []string // Hazard types negated while carrying or wearing this item
+}
+
+// Item definitions catalog
+// Weapon items share their key with WeaponData
+var ItemData = map[string]ItemStats{
+	// Consumables
+	"medkit": {DisplayName: "Medkit", Type: ITEM_TYPE_CONSUMABLE, MaxStack: 5, Weight: 500, HealAmount: 60, UseDuration: 5 * time.Second, Cooldown: 10 * time.Second, Cures: []string{"bleeding", "poison"}},
+	"stim":   {DisplayName: "Stim", Type: ITEM_TYPE_CONSUMABLE, MaxStack: 10, Weight: 100, HealAmount: 20, UseDuration: 1 * time.Second, Cooldown: 5 * time.Second, Cures: []string{"slow"}},
+	// Ammo
+	"rifle_ammo_box":   {DisplayName: "Rifle Ammo Box", Type: ITEM_TYPE_AMMO, MaxStack: 10, Weight: 450, AmmoType: "rifle", AmmoAmount: 30, UseDuration: 2 * time.Second},
+	"shotgun_ammo_box": {DisplayName: "Shotgun Shells Box", Type: ITEM_TYPE_AMMO, MaxStack: 10, Weight: 350, AmmoType: "shotgun", AmmoAmount: 8, UseDuration: 2 * time.Second},
+	// Armor
+	"light_helmet": {DisplayName: "Light Helmet", Type: ITEM_TYPE_ARMOR, MaxStack: 1, Weight: 1200, ArmorSlot: ARMOR_SLOT_HELMET, MaxDurability: 60,
+		Resistances: map[string]float64{DAMAGE_TYPE_BULLET: 0.3, DAMAGE_TYPE_EXPLOSIVE: 0.2}},
+	"heavy_helmet": {DisplayName: "Heavy Helmet", Type: ITEM_TYPE_ARMOR, MaxStack: 1, Weight: 2200, ArmorSlot: ARMOR_SLOT_HELMET, MaxDurability: 120,
+		Resistances: map[string]float64{DAMAGE_TYPE_BULLET: 0.5, DAMAGE_TYPE_EXPLOSIVE: 0.35}},
+	"light_vest": {DisplayName: "Light Vest", Type: ITEM_TYPE_ARMOR, MaxStack: 1, Weight: 3500, ArmorSlot: ARMOR_SLOT_VEST, MaxDurability: 100,
+		Resistances: map[string]float64{DAMAGE_TYPE_BULLET: 0.3, DAMAGE_TYPE_EXPLOSIVE: 0.25, DAMAGE_TYPE_FIRE: 0.1}},
+	"heavy_vest": {DisplayName: "Heavy Vest", Type: ITEM_TYPE_ARMOR, MaxStack: 1, Weight: 7500, ArmorSlot: ARMOR_SLOT_VEST, MaxDurability: 200,
+		Resistances: map[string]float64{DAMAGE_TYPE_BULLET: 0.5, DAMAGE_TYPE_EXPLOSIVE: 0.4, DAMAGE_TYPE_FIRE: 0.2}},
+	// Gear
+	"gas_mask":    {DisplayName: "Gas Mask", Type: ITEM_TYPE_GEAR, MaxStack: 1, Weight: 800, Protects: []string{HAZARD_TYPE_GAS}},
+	"hazmat_suit": {DisplayName: "Hazmat Suit", Type: ITEM_TYPE_GEAR, MaxStack: 1, Weight: 4000, Protects: []string{HAZARD_TYPE_GAS, HAZARD_TYPE_RADIATION}},
+	"fire_cloak":  {DisplayName: "Fire Cloak", Type: ITEM_TYPE_GEAR, MaxStack: 1, Weight: 2500, Protects: []string{HAZARD_TYPE_FIRE}},
+	// Keys
+	"bunker_key": {DisplayName: "Bunker Key", Type: ITEM_TYPE_KEY, MaxStack: 1, Weight: 10},
+	// Resources
+	"scrap_metal": {DisplayName: "Scrap Metal", Type: ITEM_TYPE_RESOURCE, MaxStack: 50, Weight: 250},
+	"cloth":       {DisplayName: "Cloth", Type: ITEM_TYPE_RESOURCE, MaxStack: 50, Weight: 50},
+	// Weapons
+	"m16_rifle":            {DisplayName: "M16 Rifle", Type: ITEM_TYPE_WEAPON, MaxStack: 1, Weight: 3300, WeaponType: "rifle"},
+	"akm_rifle":            {DisplayName: "AKM Rifle", Type: ITEM_TYPE_WEAPON, MaxStack: 1, Weight: 3600, WeaponType: "rifle"},
+	"remington870_shotgun": {DisplayName: "Remington 870 Shotgun", Type: ITEM_TYPE_WEAPON, MaxStack: 1, Weight: 3600, WeaponType: "shotgun"},
+}
+
+// Helper function to get item stats
+func GetItemStats(itemName string) (ItemStats, bool) {
+	stats, exists := ItemData[itemName]
+	return stats, exists
+}
+
+// Returns the ammo box item that can be loaded into this weapon type
+func GetAmmoItemName(weaponType string) (string, bool) {
+	for itemName, stats := range ItemData {
+		if stats.Type == ITEM_TYPE_AMMO && stats.AmmoType == weaponType {
+			return itemName, true
+		}
+	}
+	return "", false
+}
+
+// Returns true if this item can be used from the inventory
+func (stats ItemStats) IsUsable() bool {
+	return stats.Type == ITEM_TYPE_CONSUMABLE || stats.Type == ITEM_TYPE_AMMO
+}
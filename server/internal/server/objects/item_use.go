@@ -0,0 +1,14 @@
+package objects
+
+import "time"
+
+// Item a player started using, it only takes effect once the use duration is over
+type ItemUse struct {
+	ItemName string
+	FinishAt time.Time
+}
+
+// Returns true once the player has been using the item for long enough
+func (itemUse *ItemUse) IsFinished(now time.Time) bool {
+	return !now.Before(itemUse.FinishAt)
+}
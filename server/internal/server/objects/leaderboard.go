@@ -0,0 +1,52 @@
+package objects
+
+import "time"
+
+// Leaderboard categories
+// Faction standing can't be ranked yet since the game has no factions
+const (
+	LEADERBOARD_KILLS            string = "kills"
+	LEADERBOARD_KILL_DEATH_RATIO string = "kd"
+	LEADERBOARD_LEVEL            string = "level"
+	LEADERBOARD_MATCH_WINS       string = "match_wins"
+)
+
+var LEADERBOARD_CATEGORIES = []string{
+	LEADERBOARD_KILLS,
+	LEADERBOARD_KILL_DEATH_RATIO,
+	LEADERBOARD_LEVEL,
+	LEADERBOARD_MATCH_WINS,
+}
+
+type LeaderboardEntry struct {
+	Rank        uint64 // Starting from 1
+	CharacterId int64
+	Nickname    string
+	Value       float64 // Kills, ratio, level or wins depending on the category
+}
+
+// Snapshot of a ranking, it's never modified once created so it can be shared between goroutines
+type Leaderboard struct {
+	Category  string
+	Entries   []*LeaderboardEntry // Best first
+	UpdatedAt time.Time
+}
+
+// Returns the number of pages of this size, there is always at least one (maybe empty)
+func (leaderboard *Leaderboard) GetPageCount(pageSize uint64) uint64 {
+	count := (uint64(len(leaderboard.Entries)) + pageSize - 1) / pageSize
+	return max(count, 1)
+}
+
+// Returns the entries of a page, starting from page 1 (empty if it's past the last page)
+func (leaderboard *Leaderboard) GetPage(page uint64, pageSize uint64) []*LeaderboardEntry {
+	if page == 0 {
+		page = 1
+	}
+	start := (page - 1) * pageSize
+	if start >= uint64(len(leaderboard.Entries)) {
+		return nil
+	}
+	end := min(start+pageSize, uint64(len(leaderboard.Entries)))
+	return leaderboard.Entries[start:end]
+}
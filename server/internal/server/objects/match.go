@@ -0,0 +1,302 @@
+package objects
+
+import (
+	"sort"
+	"time"
+)
+
+// Match modes
+const (
+	MATCH_MODE_DEATHMATCH      string = "deathmatch"
+	MATCH_MODE_TEAM_DEATHMATCH string = "team_deathmatch"
+)
+
+// Match phases, a match loops through them in this order
+const (
+	MATCH_PHASE_LOBBY  string = "lobby"  // Waiting for enough players
+	MATCH_PHASE_WARMUP string = "warmup" // Players can fight but nothing is scored
+	MATCH_PHASE_LIVE   string = "live"   // Kills are scored until the time or score limit
+	MATCH_PHASE_ENDED  string = "ended"  // Results are shown, nobody can be damaged
+)
+
+// Team of characters that aren't in a team (deathmatch)
+const NO_TEAM uint64 = 0
+
+type MatchConfig struct {
+	Mode           string
+	MinPlayers     int
+	WarmupDuration time.Duration
+	TimeLimit      time.Duration
+	ScoreLimit     uint64 // Kills of a player (deathmatch) or a team (team deathmatch) that end the match
+	Teams          uint64 // Number of teams in team deathmatch
+	FriendlyFire   bool   // Can teammates damage each other
+	RespawnDelay   time.Duration
+	ResultsTime    time.Duration // Time the results are shown before going back to the lobby
+}
+
+// Score of a single participant
+type MatchScore struct {
+	ClientId    uint64
+	CharacterId int64
+	Name        string
+	Team        uint64
+	Kills       uint64
+	Deaths      uint64
+}
+
+type Match struct {
+	Config       MatchConfig
+	Phase        string
+	PhaseEndsAt  time.Time // Zero while the phase has no time limit (lobby)
+	StartedAt    time.Time // When the live phase started
+	scores       map[uint64]*MatchScore
+	teamScores   map[uint64]uint64
+	respawnTimes map[uint64]time.Time // Client ID to the time it will be respawned
+}
+
+// Creates a match waiting in the lobby
+func CreateMatch(config MatchConfig) *Match {
+	return &Match{
+		Config:       config,
+		Phase:        MATCH_PHASE_LOBBY,
+		scores:       make(map[uint64]*MatchScore),
+		teamScores:   make(map[uint64]uint64),
+		respawnTimes: make(map[uint64]time.Time),
+	}
+}
+
+func (match *Match) IsTeamMode() bool {
+	return match.Config.Mode == MATCH_MODE_TEAM_DEATHMATCH
+}
+
+// Changes the phase and sets when it ends (0 for no limit)
+func (match *Match) SetPhase(phase string, now time.Time, duration time.Duration) {
+	match.Phase = phase
+	if duration > 0 {
+		match.PhaseEndsAt = now.Add(duration)
+	} else {
+		match.PhaseEndsAt = time.Time{}
+	}
+	if phase == MATCH_PHASE_LIVE {
+		match.StartedAt = now
+	}
+}
+
+// Returns true if the current phase has a time limit and it ran out
+func (match *Match) IsPhaseOver(now time.Time) bool {
+	return !match.PhaseEndsAt.IsZero() && !now.Before(match.PhaseEndsAt)
+}
+
+// Returns the time left in the current phase (0 if it has no limit)
+func (match *Match) GetTimeLeft(now time.Time) time.Duration {
+	if match.PhaseEndsAt.IsZero() || !now.Before(match.PhaseEndsAt) {
+		return 0
+	}
+	return match.PhaseEndsAt.Sub(now)
+}
+
+// Adds a participant, in team mode they join the team with the fewest players
+func (match *Match) AddParticipant(clientId uint64, characterId int64, name string) *MatchScore {
+	if score, exists := match.scores[clientId]; exists {
+		return score
+	}
+
+	team := NO_TEAM
+	if match.IsTeamMode() && match.Config.Teams > 0 {
+		// Count how many players each team has
+		sizes := make(map[uint64]int)
+		for _, score := range match.scores {
+			sizes[score.Team]++
+		}
+		team = 1
+		for candidate := uint64(2); candidate <= match.Config.Teams; candidate++ {
+			if sizes[candidate] < sizes[team] {
+				team = candidate
+			}
+		}
+	}
+
+	score := &MatchScore{ClientId: clientId, CharacterId: characterId, Name: name, Team: team}
+	match.scores[clientId] = score
+	return score
+}
+
+func (match *Match) RemoveParticipant(clientId uint64) {
+	delete(match.scores, clientId)
+	delete(match.respawnTimes, clientId)
+}
+
+func (match *Match) GetParticipant(clientId uint64) (*MatchScore, bool) {
+	score, exists := match.scores[clientId]
+	return score, exists
+}
+
+func (match *Match) GetParticipantCount() int {
+	return len(match.scores)
+}
+
+// Returns every participant sorted by kills, then by fewest deaths
+func (match *Match) GetScores() []*MatchScore {
+	scores := make([]*MatchScore, 0, len(match.scores))
+	for _, score := range match.scores {
+		scores = append(scores, score)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Kills != scores[j].Kills {
+			return scores[i].Kills > scores[j].Kills
+		}
+		if scores[i].Deaths != scores[j].Deaths {
+			return scores[i].Deaths < scores[j].Deaths
+		}
+		return scores[i].ClientId < scores[j].ClientId
+	})
+	return scores
+}
+
+// Returns the score of each team, indexed from team 1
+func (match *Match) GetTeamScores() []uint64 {
+	teamScores := make([]uint64, match.Config.Teams)
+	for team := range match.Config.Teams {
+		teamScores[team] = match.teamScores[team+1]
+	}
+	return teamScores
+}
+
+// Clears every score but keeps the participants and their teams
+func (match *Match) ResetScores() {
+	for _, score := range match.scores {
+		score.Kills = 0
+		score.Deaths = 0
+	}
+	match.teamScores = make(map[uint64]uint64)
+	match.respawnTimes = make(map[uint64]time.Time)
+}
+
+// Returns true if the attacker is allowed to damage the target
+func (match *Match) CanDamage(attackerId, targetId uint64) bool {
+	if match.Phase == MATCH_PHASE_ENDED {
+		return false
+	}
+	if attackerId == targetId || match.Config.FriendlyFire || !match.IsTeamMode() {
+		return true
+	}
+	attacker, attackerExists := match.scores[attackerId]
+	target, targetExists := match.scores[targetId]
+	// NPCs and the environment are in no team
+	if !attackerExists || !targetExists {
+		return true
+	}
+	return attacker.Team != target.Team
+}
+
+// Scores a death, the attacker may not be a participant (NPCs, environment)
+// Only the live phase counts, returns true if the kill was scored
+func (match *Match) RecordKill(attackerId, targetId uint64) bool {
+	if match.Phase != MATCH_PHASE_LIVE {
+		return false
+	}
+
+	target, exists := match.scores[targetId]
+	if !exists {
+		return false
+	}
+	target.Deaths++
+
+	attacker, exists := match.scores[attackerId]
+	if !exists || attackerId == targetId {
+		return true
+	}
+	// Killing a teammate is never rewarded
+	if match.IsTeamMode() && attacker.Team == target.Team {
+		return true
+	}
+
+	attacker.Kills++
+	if attacker.Team != NO_TEAM {
+		match.teamScores[attacker.Team]++
+	}
+	return true
+}
+
+// Returns true if a player or team reached the score limit
+func (match *Match) IsScoreLimitReached() bool {
+	if match.Config.ScoreLimit == 0 {
+		return false
+	}
+	if match.IsTeamMode() {
+		for _, teamScore := range match.teamScores {
+			if teamScore >= match.Config.ScoreLimit {
+				return true
+			}
+		}
+		return false
+	}
+	for _, score := range match.scores {
+		if score.Kills >= match.Config.ScoreLimit {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the team with the most kills, NO_TEAM on ties or if nobody scored
+func (match *Match) GetWinningTeam() uint64 {
+	var winner, best uint64 = NO_TEAM, 0
+	tie := false
+	for team, teamScore := range match.teamScores {
+		if teamScore > best {
+			winner, best, tie = team, teamScore, false
+		} else if teamScore == best {
+			tie = true
+		}
+	}
+	if tie || best == 0 {
+		return NO_TEAM
+	}
+	return winner
+}
+
+// Returns the participant with the most kills, nil on ties or if nobody scored
+func (match *Match) GetWinningPlayer() *MatchScore {
+	scores := match.GetScores()
+	if len(scores) == 0 || scores[0].Kills == 0 {
+		return nil
+	}
+	if len(scores) > 1 && scores[1].Kills == scores[0].Kills && scores[1].Deaths == scores[0].Deaths {
+		return nil
+	}
+	return scores[0]
+}
+
+// Returns true if this participant won, either alone or with their team
+func (match *Match) IsWinner(score *MatchScore) bool {
+	if match.IsTeamMode() {
+		winningTeam := match.GetWinningTeam()
+		return winningTeam != NO_TEAM && score.Team == winningTeam
+	}
+	return match.GetWinningPlayer() == score
+}
+
+// Schedules a dead participant to come back after the respawn delay
+func (match *Match) ScheduleRespawn(clientId uint64, now time.Time) {
+	if _, exists := match.scores[clientId]; exists {
+		match.respawnTimes[clientId] = now.Add(match.Config.RespawnDelay)
+	}
+}
+
+// Returns when the participant will be respawned (zero if they aren't waiting)
+func (match *Match) GetRespawnTime(clientId uint64) time.Time {
+	return match.respawnTimes[clientId]
+}
+
+// Returns and forgets every participant whose respawn delay is over
+func (match *Match) PopDueRespawns(now time.Time) []uint64 {
+	var due []uint64
+	for clientId, respawnAt := range match.respawnTimes {
+		if !now.Before(respawnAt) {
+			due = append(due, clientId)
+			delete(match.respawnTimes, clientId)
+		}
+	}
+	return due
+}
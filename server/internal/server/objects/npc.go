@@ -0,0 +1,171 @@
+package objects
+
+import (
+	"math"
+	"math/rand"
+	"server/internal/server/ai"
+	"server/internal/server/pathfinding"
+	"sync/atomic"
+	"time"
+)
+
+// NPC IDs start here so they never collide with client IDs,
+// this way the client can report damage to NPCs and players with the same packet
+const NPC_ID_OFFSET uint64 = 1_000_000
+
+var lastNpcId atomic.Uint64
+
+// Character controlled by the server
+type NPC struct {
+	Id       uint64
+	Template string // Key in NpcData
+	Name     string
+	// Position
+	Position    *pathfinding.Cell
+	Destination *pathfinding.Cell
+	RotationY   float64
+	Post        *pathfinding.Cell // Cell this NPC guards, where it was spawned
+	CoverCell   *pathfinding.Cell // Cell this NPC is hiding in or running to (nil if none)
+	// Patrol, NPCs without a route or area just guard their post
+	PatrolRoute     []*pathfinding.Cell // Cells visited in order, looping back to the first one
+	WanderArea      []*pathfinding.Cell // Cells visited at random when there is no route
+	patrolIndex     int
+	PatrolWaitUntil time.Time // Time to leave the current post (zero if we haven't reached it yet)
+	// Attributes
+	health    uint64
+	maxHealth uint64
+	// Character state
+	isCrouching bool
+	// AI state
+	TargetId      uint64       // Client this NPC is fighting (0 if none)
+	Goal          string       // Goal the current plan tries to achieve
+	Plan          []*ai.Action // Remaining actions of the current plan
+	LastDamagedAt time.Time
+	BlockUntil    time.Time
+	NextThinkAt   time.Time
+	NextMoveAt    time.Time
+	NextFireAt    time.Time
+	// Dormant NPCs don't think or move, their spawner has no players nearby
+	Dormant bool
+}
+
+// Creates an NPC from a template, returns false if the template doesn't exist
+func CreateNPC(template string, rotation float64) (*NPC, bool) {
+	stats, exists := GetNpcStats(template)
+	if !exists {
+		return nil, false
+	}
+
+	return &NPC{
+		Id:        NPC_ID_OFFSET + lastNpcId.Add(1),
+		Template:  template,
+		Name:      stats.DisplayName,
+		RotationY: rotation,
+		health:    stats.MaxHealth,
+		maxHealth: stats.MaxHealth,
+	}, true
+}
+
+// Returns true if this ID belongs to an NPC instead of a client
+func IsNpcId(id uint64) bool {
+	return id > NPC_ID_OFFSET
+}
+
+// Returns the template stats of this NPC
+func (npc *NPC) GetStats() NpcStats {
+	stats, _ := GetNpcStats(npc.Template)
+	return stats
+}
+
+// Model look at rotation get/set
+func (npc *NPC) GetRotation() float64 {
+	return npc.RotationY
+}
+func (npc *NPC) SetRotation(newRotation float64) {
+	npc.RotationY = newRotation
+}
+
+// Grid Position get/set
+func (npc *NPC) GetGridPosition() *pathfinding.Cell {
+	return npc.Position
+}
+func (npc *NPC) SetGridPosition(cell *pathfinding.Cell) {
+	npc.Position = cell
+}
+
+// Grid Destination get/set
+func (npc *NPC) GetGridDestination() *pathfinding.Cell {
+	return npc.Destination
+}
+func (npc *NPC) SetGridDestination(cell *pathfinding.Cell) {
+	npc.Destination = cell
+}
+
+// Health get
+func (npc *NPC) GetHealth() uint64 {
+	return npc.health
+}
+func (npc *NPC) GetMaxHealth() uint64 {
+	return npc.maxHealth
+}
+
+// Decrease health by amount, clamped at 0
+func (npc *NPC) DecreaseHealth(amount uint64) {
+	if amount >= npc.health {
+		npc.health = 0
+	} else {
+		npc.health -= amount
+	}
+}
+
+func (npc *NPC) IsAlive() bool {
+	return npc.health > 0
+}
+
+func (npc *NPC) IsCrouching() bool {
+	return npc.isCrouching
+}
+func (npc *NPC) SetCrouching(crouching bool) {
+	npc.isCrouching = crouching
+}
+
+// Returns true if this NPC moves around when it has nothing to fight
+func (npc *NPC) HasPatrol() bool {
+	return len(npc.PatrolRoute) > 0 || len(npc.WanderArea) > 0
+}
+
+// Returns the next cell this NPC should guard, following its route or wandering within its area
+func (npc *NPC) NextPatrolCell() *pathfinding.Cell {
+	if len(npc.PatrolRoute) > 0 {
+		cell := npc.PatrolRoute[npc.patrolIndex]
+		npc.patrolIndex = (npc.patrolIndex + 1) % len(npc.PatrolRoute)
+		return cell
+	}
+	if len(npc.WanderArea) > 0 {
+		return npc.WanderArea[rand.Intn(len(npc.WanderArea))]
+	}
+	return npc.Post
+}
+
+// Blocking halves the damage of the next hits
+func (npc *NPC) IsBlocking(now time.Time) bool {
+	return now.Before(npc.BlockUntil)
+}
+
+// Looks at a cell, any angle is allowed (players only turn in 45° steps)
+func (npc *NPC) LookAt(cell *pathfinding.Cell) {
+	if npc.Position == nil || cell == nil || cell == npc.Position {
+		return
+	}
+	dx := float64(cell.X) - float64(npc.Position.X)
+	dz := float64(cell.Z) - float64(npc.Position.Z)
+	// SOUTH (+Z) is 0 and EAST (+X) is 90°, same as the player rotation constants
+	npc.SetRotation(math.Atan2(dx, dz))
+}
+
+// Returns the distance in cells between two cells, diagonal steps count as one
+func CellDistance(a, b *pathfinding.Cell) uint64 {
+	dx := int64(a.X) - int64(b.X)
+	dz := int64(a.Z) - int64(b.Z)
+	return uint64(max(dx, -dx, dz, -dz))
+}
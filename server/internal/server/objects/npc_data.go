@@ -0,0 +1,62 @@
+package objects
+
+import (
+	"server/internal/server/ai"
+	"time"
+)
+
+// Item an NPC can drop when it dies
+type NpcDrop struct {
+	ItemName string
+	Quantity uint64
+	Chance   float64 // Chance (0 to 1) of this item being dropped
+}
+
+type NpcStats struct {
+	DisplayName  string
+	MaxHealth    uint64
+	MoveInterval time.Duration // Time it takes to step into the next cell
+	WeaponName   string        // Entry in WeaponData used to roll the damage of each attack
+	SightRange   uint64        // Max distance in cells to notice a player
+	AttackRange  uint64        // Max distance in cells to attack the target
+	FireInterval time.Duration // Time between attacks
+	Accuracy     float64       // Chance (0 to 1) of each attack hitting the target
+	Goals        []string      // Goals this NPC can pursue, see ai.GetGoalPriority
+	Actions      []string      // Actions this NPC can perform to reach its goals
+	Drops        []*NpcDrop
+}
+
+// NPC templates, regions spawn NPCs by template name
+var NpcData = map[string]NpcStats{
+	// Human scavenger with a rifle, keeps its distance and fights from cover
+	"raider": {
+		DisplayName: "Raider", MaxHealth: 80, MoveInterval: 400 * time.Millisecond,
+		WeaponName: "akm_rifle", SightRange: 8, AttackRange: 6, FireInterval: 1200 * time.Millisecond, Accuracy: 0.5,
+		Goals: []string{ai.GOAL_GUARD, ai.GOAL_KILL, ai.GOAL_DODGE, ai.GOAL_COVER, ai.GOAL_AMBUSH},
+		Actions: []string{
+			ai.ACTION_IDLE, ai.ACTION_DODGE, ai.ACTION_DODGE_COVERED, ai.ACTION_MOVE_TO_POST, ai.ACTION_MOVE_TO_TARGET,
+			ai.ACTION_MOVE_TO_COVER, ai.ACTION_ATTACK_RANGED, ai.ACTION_ATTACK_RANGED_FROM_COVER, ai.ACTION_BLIND_ATTACK_RANGED_FROM_COVER,
+		},
+		Drops: []*NpcDrop{
+			{ItemName: "rifle_ammo_box", Quantity: 1, Chance: 0.5},
+			{ItemName: "medkit", Quantity: 1, Chance: 0.2},
+			{ItemName: "akm_rifle", Quantity: 1, Chance: 0.05},
+		},
+	},
+	// Scourge mutated beast, runs straight at its prey
+	"scourge_hound": {
+		DisplayName: "Scourge Hound", MaxHealth: 50, MoveInterval: 250 * time.Millisecond,
+		WeaponName: "scourge_claws", SightRange: 6, AttackRange: 1, FireInterval: 800 * time.Millisecond, Accuracy: 0.8,
+		Goals:   []string{ai.GOAL_GUARD, ai.GOAL_KILL, ai.GOAL_DODGE},
+		Actions: []string{ai.ACTION_BLOCK, ai.ACTION_MOVE_TO_POST, ai.ACTION_MOVE_TO_TARGET, ai.ACTION_ATTACK_MELEE},
+		Drops: []*NpcDrop{
+			{ItemName: "scrap_metal", Quantity: 2, Chance: 0.3},
+		},
+	},
+}
+
+// Helper function to get NPC stats
+func GetNpcStats(template string) (NpcStats, bool) {
+	stats, exists := NpcData[template]
+	return stats, exists
+}
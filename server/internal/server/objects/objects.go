@@ -3,6 +3,7 @@ package objects
 import (
 	"math"
 	"server/internal/server/pathfinding"
+	"time"
 )
 
 const (
@@ -52,6 +53,26 @@ type Player struct {
 	weapons       []*WeaponSlot // Array of weapon slots
 	// Character state
 	isCrouching bool
+	// Items carried outside of the weapon slots
+	inventory *Inventory
+	// Equipped armor pieces (nil if the slot is empty)
+	armor [MAX_ARMOR_SLOTS]*InventoryItem
+	// Guild
+	guildId     uint64 // Guild this player belongs to (0 if none)
+	guildTag    string // Tag displayed next to the nickname
+	guildInvite uint64 // Guild that invited this player and is waiting for an answer
+	// Consumables
+	itemUse       *ItemUse             // Item being used right now (nil if none)
+	itemCooldowns map[string]time.Time // Item name to the time it can be used again
+	// Active status effects by name
+	statusEffects map[string]*StatusEffect
+	// Combat record since the character was last saved
+	unsavedStats *CombatStats
+	// Respawn
+	respawnAt      time.Time // When a dead player can respawn
+	protectedUntil time.Time // End of the spawn protection
+	// Moderation
+	mute *Restriction // Active mute given by an admin (nil if none)
 }
 
 // RegionId get/set
@@ -94,6 +115,70 @@ func (player *Player) SetGridDestination(cell *pathfinding.Cell) {
 	player.Destination = cell
 }
 
+// Inventory get/set
+func (player *Player) GetInventory() *Inventory {
+	return player.inventory
+}
+func (player *Player) SetInventory(inventory *Inventory) {
+	player.inventory = inventory
+}
+
+// ItemUse get/set
+func (player *Player) GetItemUse() *ItemUse {
+	return player.itemUse
+}
+func (player *Player) SetItemUse(itemUse *ItemUse) {
+	player.itemUse = itemUse
+}
+
+// Item cooldowns
+func (player *Player) IsItemOnCooldown(itemName string, now time.Time) bool {
+	return now.Before(player.itemCooldowns[itemName])
+}
+func (player *Player) SetItemCooldown(itemName string, until time.Time) {
+	player.itemCooldowns[itemName] = until
+}
+
+// Combat stats recorded since the last save
+func (player *Player) GetUnsavedStats() *CombatStats {
+	return player.unsavedStats
+}
+
+// Returns the unsaved stats and starts a new empty record
+func (player *Player) TakeUnsavedStats() *CombatStats {
+	stats := player.unsavedStats
+	player.unsavedStats = CreateCombatStats()
+	return stats
+}
+
+// Mute get/set
+func (player *Player) GetMute() *Restriction {
+	return player.mute
+}
+func (player *Player) SetMute(mute *Restriction) {
+	player.mute = mute
+}
+
+// Guild get/set
+func (player *Player) GetGuildId() uint64 {
+	return player.guildId
+}
+func (player *Player) GetGuildTag() string {
+	return player.guildTag
+}
+func (player *Player) SetGuild(guildId uint64, guildTag string) {
+	player.guildId = guildId
+	player.guildTag = guildTag
+}
+
+// Pending guild invite get/set
+func (player *Player) GetGuildInvite() uint64 {
+	return player.guildInvite
+}
+func (player *Player) SetGuildInvite(guildId uint64) {
+	player.guildInvite = guildId
+}
+
 // Move speed get/set
 func (player *Player) GetSpeed() uint64 {
 	return player.speed
@@ -220,23 +305,50 @@ func (player *Player) IsAlive() bool {
 	return player.health > 0
 }
 
-// Respawn resets the player's stats back to default
-func (player *Player) Respawn(rotation float64) {
-	player.health = player.maxHealth // Back to full health (could respawn with 10%?)
-	player.RotationY = rotation      // Get the rotation from the server respawner
+// Respawn resets the player's stats, the region decides how much health (percent of the max) and if the ammo is refilled
+func (player *Player) Respawn(rotation float64, healthPercent uint64, refillAmmo bool) {
+	// Always come back with at least 1 health
+	player.health = max(player.maxHealth*healthPercent/100, 1)
+	player.RotationY = rotation // Get the rotation from the server respawner
+	player.itemUse = nil        // Dying interrupts any item we were using
+	player.ClearStatusEffects() // Respawn without any status effects
+	player.respawnAt = time.Time{}
+
+	if !refillAmmo {
+		return
+	}
 
 	// Reset all weapons to default ammo
 	for _, weapon := range player.weapons {
 		if weapon != nil {
 			stats, exists := GetWeaponStats(weapon.WeaponName)
-			if exists {
+			// Melee and unarmed slots have nothing to refill
+			if exists && stats.MagazineCapacity > 0 {
 				// Full magazine plus one bullet chambered
-				player.SetCurrentWeaponAmmo(true, stats.MagazineCapacity+1, stats.ReserveCapacity)
+				weapon.Chambered = true
+				weapon.Ammo = stats.MagazineCapacity + 1
+				weapon.ReserveAmmo = stats.ReserveCapacity
 			}
 		}
 	}
 }
 
+// Respawn timer, set when the player dies (zero if they can respawn right away)
+func (player *Player) GetRespawnTime() time.Time {
+	return player.respawnAt
+}
+func (player *Player) SetRespawnTime(respawnAt time.Time) {
+	player.respawnAt = respawnAt
+}
+
+// Spawn protection, attacks can't hurt us until it runs out or we fire
+func (player *Player) IsSpawnProtected(now time.Time) bool {
+	return now.Before(player.protectedUntil)
+}
+func (player *Player) SetSpawnProtection(until time.Time) {
+	player.protectedUntil = until
+}
+
 // Returns the default respawn location for this region
 // We should probably set it up as a dictionary where each key is a region_id and the value
 // is an array of valid respawn coordinates.
@@ -279,6 +391,12 @@ func CreatePlayer(
 		weapons:       weapons,
 		// Character state
 		isCrouching: isCrouching,
+		// Empty until the items are loaded from the database
+		inventory: CreateInventory(INVENTORY_CAPACITY, INVENTORY_MAX_WEIGHT),
+		// No items have been used yet
+		itemCooldowns: make(map[string]time.Time),
+		statusEffects: make(map[string]*StatusEffect),
+		unsavedStats:  CreateCombatStats(),
 	}
 }
 
@@ -442,6 +560,45 @@ func (player *Player) FireCurrentWeapon() bool {
 	return true
 }
 
+// Returns the slot of a weapon of this type with room in its reserve, preferring the equipped weapon
+func (player *Player) GetReserveAmmoSlot(weaponType string) (uint64, bool) {
+	// Try the equipped weapon first, then every other slot in order
+	slots := []uint64{player.currentWeapon}
+	for slot := range MAX_WEAPON_SLOTS {
+		if slot != player.currentWeapon {
+			slots = append(slots, slot)
+		}
+	}
+
+	for _, slot := range slots {
+		weapon := player.GetWeaponSlot(slot)
+		if weapon == nil || weapon.WeaponType != weaponType {
+			continue
+		}
+		stats, exists := GetWeaponStats(weapon.WeaponName)
+		if exists && weapon.ReserveAmmo < stats.ReserveCapacity {
+			return slot, true
+		}
+	}
+
+	return 0, false
+}
+
+// Adds bullets to the reserve of a weapon of this type, preferring the equipped weapon
+// Returns the slot that received the bullets and how many were added
+func (player *Player) AddReserveAmmo(weaponType string, amount uint64) (uint64, uint64) {
+	slot, found := player.GetReserveAmmoSlot(weaponType)
+	if !found {
+		return 0, 0
+	}
+
+	weapon := player.GetWeaponSlot(slot)
+	stats, _ := GetWeaponStats(weapon.WeaponName)
+	added := min(amount, stats.ReserveCapacity-weapon.ReserveAmmo)
+	weapon.ReserveAmmo += added
+	return slot, added
+}
+
 // Check if we ran out of ammo (no bullets in weapon)
 func (player *Player) IsCurrentWeaponEmpty() bool {
 	weapon := player.weapons[player.currentWeapon]
@@ -0,0 +1,51 @@
+package objects
+
+import (
+	"fmt"
+	"time"
+)
+
+// Kinds of account restrictions the admins can give
+const (
+	RESTRICTION_BAN  string = "ban"  // Can't log in
+	RESTRICTION_MUTE string = "mute" // Can't chat
+)
+
+// A ban or a mute on an account
+type Restriction struct {
+	Kind      string
+	Reason    string
+	ExpiresAt time.Time // Zero if it never expires
+}
+
+// Permanent restrictions never expire
+func (restriction *Restriction) IsPermanent() bool {
+	return restriction.ExpiresAt.IsZero()
+}
+
+// Nil restrictions are never active, so callers don't need to check
+func (restriction *Restriction) IsActive(now time.Time) bool {
+	if restriction == nil {
+		return false
+	}
+	return restriction.IsPermanent() || now.Before(restriction.ExpiresAt)
+}
+
+// Message shown to the player, e.g. "You are muted for 10 more minutes: spam"
+func (restriction *Restriction) Describe(now time.Time) string {
+	duration := "permanently"
+	if !restriction.IsPermanent() {
+		duration = fmt.Sprintf("for %d more minutes", int(restriction.ExpiresAt.Sub(now).Minutes())+1)
+	}
+
+	verb := "muted"
+	if restriction.Kind == RESTRICTION_BAN {
+		verb = "banned"
+	}
+
+	message := fmt.Sprintf("You are %s %s", verb, duration)
+	if restriction.Reason != "" {
+		message += ": " + restriction.Reason
+	}
+	return message
+}
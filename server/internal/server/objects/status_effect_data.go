@@ -0,0 +1,47 @@
+package objects
+
+import "time"
+
+// What happens when an effect that is already active gets applied again
+const (
+	STACKING_REFRESH   string = "refresh"   // Resets the duration
+	STACKING_INTENSITY string = "intensity" // Adds a stack (up to MaxStacks) and resets the duration
+	STACKING_NONE      string = "none"      // Ignored until the active one expires
+)
+
+type StatusEffectStats struct {
+	DisplayName string
+	Duration    time.Duration
+	Stacking    string
+	MaxStacks   uint64
+	// Periodic effects, multiplied by the number of stacks
+	TickInterval  time.Duration // 0 if the effect does nothing over time
+	DamagePerTick uint64
+	HealPerTick   uint64
+	DamageType    string
+	// Modifiers while the effect is active
+	SpeedPenalty    float64 // Fraction of movement speed lost (0 to 1)
+	AccuracyPenalty float64 // Fraction of accuracy lost (0 to 1), the client widens its spread
+	Stuns           bool    // Can't move or shoot
+}
+
+// Status effect definitions catalog
+var StatusEffectData = map[string]StatusEffectStats{
+	"bleeding": {DisplayName: "Bleeding", Duration: 10 * time.Second, Stacking: STACKING_INTENSITY, MaxStacks: 5,
+		TickInterval: time.Second, DamagePerTick: 2, DamageType: "bleeding"},
+	"poison": {DisplayName: "Poisoned", Duration: 15 * time.Second, Stacking: STACKING_INTENSITY, MaxStacks: 3,
+		TickInterval: time.Second, DamagePerTick: 1, DamageType: DAMAGE_TYPE_POISON, AccuracyPenalty: 0.2},
+	"stun": {DisplayName: "Stunned", Duration: 2 * time.Second, Stacking: STACKING_NONE, MaxStacks: 1, Stuns: true},
+	"slow": {DisplayName: "Slowed", Duration: 5 * time.Second, Stacking: STACKING_REFRESH, MaxStacks: 1, SpeedPenalty: 0.5},
+	"regeneration": {DisplayName: "Regenerating", Duration: 10 * time.Second, Stacking: STACKING_REFRESH, MaxStacks: 1,
+		TickInterval: time.Second, HealPerTick: 2},
+	// Exposure to the toxic atmosphere of Eden, builds up the longer you breathe it
+	"scourge_exposure": {DisplayName: "Scourge Exposure", Duration: 30 * time.Second, Stacking: STACKING_INTENSITY, MaxStacks: 10,
+		TickInterval: 2 * time.Second, DamagePerTick: 1, DamageType: DAMAGE_TYPE_RADIATION, SpeedPenalty: 0.05, AccuracyPenalty: 0.05},
+}
+
+// Helper function to get status effect stats
+func GetStatusEffectStats(effectName string) (StatusEffectStats, bool) {
+	stats, exists := StatusEffectData[effectName]
+	return stats, exists
+}
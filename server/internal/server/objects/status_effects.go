@@ -0,0 +1,155 @@
+package objects
+
+import (
+	"math"
+	"time"
+)
+
+// Status effect active on a player
+type StatusEffect struct {
+	Name       string
+	Stacks     uint64
+	ExpiresAt  time.Time
+	NextTickAt time.Time
+}
+
+// Damage or healing done by a status effect during a tick
+type StatusEffectTick struct {
+	Name       string
+	Damage     uint64
+	Heal       uint64
+	DamageType string
+}
+
+// Returns the active effect with that name (nil if not active)
+func (player *Player) GetStatusEffect(name string) *StatusEffect {
+	return player.statusEffects[name]
+}
+
+// Returns every active effect
+func (player *Player) GetStatusEffects() map[string]*StatusEffect {
+	return player.statusEffects
+}
+
+// Applies an effect following its stacking rules
+// Returns the active effect and false if nothing changed
+func (player *Player) ApplyStatusEffect(name string, now time.Time) (*StatusEffect, bool) {
+	stats, exists := GetStatusEffectStats(name)
+	if !exists {
+		return nil, false
+	}
+
+	effect, active := player.statusEffects[name]
+	if !active {
+		effect = &StatusEffect{
+			Name:       name,
+			Stacks:     1,
+			ExpiresAt:  now.Add(stats.Duration),
+			NextTickAt: now.Add(stats.TickInterval),
+		}
+		player.statusEffects[name] = effect
+		return effect, true
+	}
+
+	switch stats.Stacking {
+	case STACKING_NONE:
+		return effect, false
+	case STACKING_INTENSITY:
+		if effect.Stacks < stats.MaxStacks {
+			effect.Stacks++
+		}
+	}
+	effect.ExpiresAt = now.Add(stats.Duration)
+
+	return effect, true
+}
+
+// Removes an effect before it expires, returns false if it wasn't active
+func (player *Player) RemoveStatusEffect(name string) bool {
+	_, active := player.statusEffects[name]
+	delete(player.statusEffects, name)
+	return active
+}
+
+// Removes every effect (death, respawn)
+func (player *Player) ClearStatusEffects() {
+	player.statusEffects = make(map[string]*StatusEffect)
+}
+
+// Returns the periodic damage and healing that is due, then removes the expired effects
+// The caller applies the damage so it can handle deaths
+func (player *Player) TickStatusEffects(now time.Time) ([]StatusEffectTick, []string) {
+	var ticks []StatusEffectTick
+	var expired []string
+
+	for name, effect := range player.statusEffects {
+		stats, _ := GetStatusEffectStats(name)
+
+		if stats.TickInterval > 0 {
+			tick := StatusEffectTick{Name: name, DamageType: stats.DamageType}
+			// Catch up on every tick we missed, but never tick past the expiration
+			for !now.Before(effect.NextTickAt) && !effect.NextTickAt.After(effect.ExpiresAt) {
+				tick.Damage += stats.DamagePerTick * effect.Stacks
+				tick.Heal += stats.HealPerTick * effect.Stacks
+				effect.NextTickAt = effect.NextTickAt.Add(stats.TickInterval)
+			}
+			if tick.Damage > 0 || tick.Heal > 0 {
+				ticks = append(ticks, tick)
+			}
+		}
+
+		if !now.Before(effect.ExpiresAt) {
+			delete(player.statusEffects, name)
+			expired = append(expired, name)
+		}
+	}
+
+	return ticks, expired
+}
+
+// Returns the multiplier applied to our movement speed by every active effect
+func (player *Player) GetSpeedModifier() float64 {
+	modifier := 1.0
+	for name := range player.statusEffects {
+		stats, _ := GetStatusEffectStats(name)
+		if stats.Stuns {
+			return 0
+		}
+		modifier *= 1 - stats.SpeedPenalty
+	}
+	return modifier
+}
+
+// Returns the multiplier applied to our accuracy by every active effect
+func (player *Player) GetAccuracyModifier() float64 {
+	modifier := 1.0
+	for name := range player.statusEffects {
+		stats, _ := GetStatusEffectStats(name)
+		if stats.Stuns {
+			return 0
+		}
+		modifier *= 1 - stats.AccuracyPenalty
+	}
+	return modifier
+}
+
+// Returns true if an active effect prevents us from moving or shooting
+func (player *Player) IsStunned() bool {
+	for name := range player.statusEffects {
+		stats, _ := GetStatusEffectStats(name)
+		if stats.Stuns {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the cells we can move per tick after applying our speed modifiers
+func (player *Player) GetEffectiveSpeed() uint64 {
+	modifier := player.GetSpeedModifier()
+	if modifier <= 0 {
+		return 0
+	}
+	// Slowed players can always move at least one cell
+	return max(1, uint64(math.Round(float64(player.speed)*modifier)))
+}
@@ -1,11 +1,19 @@
 package objects
 
+import (
+	"fmt"
+	"math/rand"
+)
+
+// The JSON names are used by the weapons.json game data file (see gamedata.go)
 type WeaponStats struct {
-	MinDamage        uint64
-	MaxDamage        uint64
-	Projectiles      int    // Number of projects per shot
-	MagazineCapacity uint64 // Max bullets in magazine (excluding chambered)
-	ReserveCapacity  uint64 // Max extra bullets player can carry
+	MinDamage        uint64  `json:"min_damage"`
+	MaxDamage        uint64  `json:"max_damage"`
+	Projectiles      int     `json:"projectiles"`       // Number of projects per shot
+	MagazineCapacity uint64  `json:"magazine_capacity"` // Max bullets in magazine (excluding chambered)
+	ReserveCapacity  uint64  `json:"reserve_capacity"`  // Max extra bullets player can carry
+	HitEffect        string  `json:"hit_effect"`        // Status effect that each hit can apply to the target
+	HitEffectChance  float64 `json:"hit_effect_chance"` // Chance (0 to 1) of each hit applying the effect
 }
 
 // Weapon statistics
@@ -16,7 +24,9 @@ var WeaponData = map[string]WeaponStats{
 	"m16_rifle": {MinDamage: 10, MaxDamage: 20, Projectiles: 1, MagazineCapacity: 30, ReserveCapacity: 90},
 	"akm_rifle": {MinDamage: 12, MaxDamage: 24, Projectiles: 1, MagazineCapacity: 30, ReserveCapacity: 90},
 	// Shotguns
-	"remington870_shotgun": {MinDamage: 5, MaxDamage: 10, Projectiles: 9, MagazineCapacity: 6, ReserveCapacity: 24},
+	"remington870_shotgun": {MinDamage: 5, MaxDamage: 10, Projectiles: 9, MagazineCapacity: 6, ReserveCapacity: 24, HitEffect: "bleeding", HitEffectChance: 0.1},
+	// Natural weapons used by NPCs
+	"scourge_claws": {MinDamage: 6, MaxDamage: 12, Projectiles: 1, HitEffect: "bleeding", HitEffectChance: 0.2},
 }
 
 // Helper function to get weapon stats
@@ -24,3 +34,40 @@ func GetWeaponStats(weaponName string) (WeaponStats, bool) {
 	stats, exists := WeaponData[weaponName]
 	return stats, exists
 }
+
+// Replaces every weapon's stats at once, only call this from the hub goroutine between ticks
+func SetWeaponData(weapons map[string]WeaponStats) {
+	WeaponData = weapons
+}
+
+// Checks the stats of a single weapon, returns a description of every problem found
+func (stats WeaponStats) Validate() []string {
+	problems := []string{}
+	if stats.MinDamage > stats.MaxDamage {
+		problems = append(problems, fmt.Sprintf("min_damage (%d) is higher than max_damage (%d)", stats.MinDamage, stats.MaxDamage))
+	}
+	if stats.Projectiles < 0 {
+		problems = append(problems, fmt.Sprintf("projectiles can't be negative, got %d", stats.Projectiles))
+	}
+	if stats.HitEffectChance < 0 || stats.HitEffectChance > 1 {
+		problems = append(problems, fmt.Sprintf("hit_effect_chance must be between 0 and 1, got %v", stats.HitEffectChance))
+	}
+	if stats.HitEffect != "" {
+		if _, exists := GetStatusEffectStats(stats.HitEffect); !exists {
+			problems = append(problems, fmt.Sprintf("unknown hit_effect %q", stats.HitEffect))
+		}
+	}
+	return problems
+}
+
+// Rolls damage for a weapon
+func RollWeaponDamage(weaponName string) uint64 {
+	stats, exists := GetWeaponStats(weaponName)
+	if exists {
+		if stats.MaxDamage > stats.MinDamage {
+			return stats.MinDamage + uint64(rand.Intn(int(stats.MaxDamage-stats.MinDamage)+1))
+		}
+		return stats.MinDamage
+	}
+	return 0
+}
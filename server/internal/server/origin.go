@@ -0,0 +1,60 @@
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Used as the websocket upgrader's CheckOrigin, browsers always send the Origin header
+// so this is what stops other websites from opening connections to our server.
+// Game clients that aren't browsers (desktop exports) send no Origin and are always let in.
+//
+// allowed_origins in the config:
+//   - empty: any origin, like before the allowlist existed, so web builds keep working after an update
+//   - "*": any origin
+//   - "https://play.example.com": exactly this scheme and host (and port if given)
+//   - "https://*.example.com": any subdomain of example.com over https
+func (h *Hub) CheckOrigin(request *http.Request) bool {
+	origin := request.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	if OriginAllowed(h.Config.AllowedOrigins, origin) {
+		return true
+	}
+	h.logger.Warn("Rejected websocket origin", "origin", origin, "remote_addr", request.RemoteAddr)
+	return false
+}
+
+// Checks an Origin header value against the allowlist
+func OriginAllowed(allowed []string, origin string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	parsed, err := url.Parse(origin)
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+
+	for _, pattern := range allowed {
+		if pattern == "*" {
+			return true
+		}
+		allowedOrigin, err := url.Parse(pattern)
+		if err != nil || !strings.EqualFold(allowedOrigin.Scheme, parsed.Scheme) {
+			continue
+		}
+		if suffix, found := strings.CutPrefix(allowedOrigin.Host, "*."); found {
+			// Subdomains only, "https://*.example.com" doesn't allow "https://example.com" itself
+			if strings.HasSuffix(strings.ToLower(parsed.Host), "."+strings.ToLower(suffix)) {
+				return true
+			}
+		} else if strings.EqualFold(allowedOrigin.Host, parsed.Host) {
+			return true
+		}
+	}
+	return false
+}
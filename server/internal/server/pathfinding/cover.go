@@ -0,0 +1,113 @@
+package pathfinding
+
+import (
+	"server/internal/server/math"
+)
+
+// Edges of a cell, used to index Cell.Cover
+const (
+	EDGE_NORTH int = 0 // -Z
+	EDGE_EAST  int = 1 // +X
+	EDGE_SOUTH int = 2 // +Z
+	EDGE_WEST  int = 3 // -X
+)
+
+// Cover values for obstacles
+const (
+	COVER_NONE float64 = 0
+	COVER_HALF float64 = 0.5 // Crates, sandbags, low walls
+	COVER_FULL float64 = 1   // Walls, columns
+)
+
+// Offsets to the neighbor across each edge
+var edgeOffsets = [4][2]int{
+	EDGE_NORTH: {0, -1},
+	EDGE_EAST:  {1, 0},
+	EDGE_SOUTH: {0, 1},
+	EDGE_WEST:  {-1, 0},
+}
+
+// Places an obstacle on a cell, nothing can walk through it
+// and the cells next to it get cover on the edge they share with it
+func (grid *Grid) SetObstacle(cell *Cell, coverValue float64) {
+	if cell == nil {
+		return
+	}
+
+	cell.Reachable = false
+	cell.CoverValue = coverValue
+
+	// Update the edge of each neighbor that faces this obstacle
+	for edge, offset := range edgeOffsets {
+		x := int(cell.X) + offset[0]
+		z := int(cell.Z) + offset[1]
+		if x < 0 || z < 0 || uint64(x) >= grid.maxWidth || uint64(z) >= grid.maxHeight {
+			continue
+		}
+		neighbor := grid.LocalToMap(uint64(x), uint64(z))
+		// The neighbor's edge that faces us is the opposite one
+		neighbor.Cover[(edge+2)%4] = coverValue
+	}
+}
+
+// Returns how much the obstacles around a cell protect it from a threat in another cell (0 to 1)
+// Only the edges facing the threat count, diagonal threats can be blocked by either edge
+func (grid *Grid) GetCoverFrom(cell *Cell, threat *Cell) float64 {
+	if cell == nil || threat == nil {
+		return COVER_NONE
+	}
+
+	dx := int(threat.X) - int(cell.X)
+	dz := int(threat.Z) - int(cell.Z)
+	absX := math.Absolute(dx)
+	absZ := math.Absolute(dz)
+
+	cover := COVER_NONE
+	// An edge only protects us if the threat isn't mostly along the other axis
+	if absX > 0 && absX*2 >= absZ {
+		if dx > 0 {
+			cover = max(cover, cell.Cover[EDGE_EAST])
+		} else {
+			cover = max(cover, cell.Cover[EDGE_WEST])
+		}
+	}
+	if absZ > 0 && absZ*2 >= absX {
+		if dz > 0 {
+			cover = max(cover, cell.Cover[EDGE_SOUTH])
+		} else {
+			cover = max(cover, cell.Cover[EDGE_NORTH])
+		}
+	}
+	return cover
+}
+
+// Returns the closest free cell within a radius that has cover from the threat, ties go to the better cover
+// The character's own cell counts as free, returns nil if there is no cover around
+func (grid *Grid) NearestCover(start *Cell, threat *Cell, radius int, character Object) *Cell {
+	var best *Cell = nil
+	var bestCover float64 = COVER_NONE
+	var bestDistance int = 0
+
+	candidates := append(grid.GetNeighbors(start, radius), start)
+	for _, cell := range candidates {
+		if !grid.IsCellReachable(cell) {
+			continue
+		}
+		if !grid.IsCellAvailable(cell) && cell.Object != character {
+			continue
+		}
+
+		cover := grid.GetCoverFrom(cell, threat)
+		if cover <= COVER_NONE {
+			continue
+		}
+
+		distance := CalculateHeuristic(int(start.X), int(start.Z), int(cell.X), int(cell.Z))
+		if best == nil || distance < bestDistance || (distance == bestDistance && cover > bestCover) {
+			best = cell
+			bestCover = cover
+			bestDistance = distance
+		}
+	}
+	return best
+}
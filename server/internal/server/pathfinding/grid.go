@@ -3,6 +3,8 @@ package pathfinding
 import (
 	"container/heap"
 	"server/internal/server/math"
+	"server/internal/server/metrics"
+	"time"
 )
 
 // Every object that can be placed in the grid should implement these
@@ -72,6 +74,9 @@ type Cell struct {
 	Z         uint64 // Forward/Backward
 	Reachable bool   // Whether this cell can be reached/stepped onto
 	Object    Object // Reference to a generic occupying object (interface)
+	// Cover
+	CoverValue float64    // How much an obstacle on this cell protects the cells around it (0 to 1)
+	Cover      [4]float64 // Protection given by the obstacles next to each edge of this cell, see EDGE_NORTH
 }
 
 // A single node in the A* algorithm
@@ -323,6 +328,8 @@ func (grid *Grid) GetNeighbors(cell *Cell, size int) []*Cell {
 // A* Pathfinding Algorithm
 // Returns a path as an array of pointers to Cell or an empty array if no path was valid
 func (grid *Grid) AStar(start *Cell, goal *Cell, character Object) []*Cell {
+	defer metrics.AStarSeconds.Since(time.Now())
+
 	// We have two sets, one has nodes to check and the other nodes that have been revised
 	openSet := make(PriorityQueue, 0)
 	heap.Init(&openSet)
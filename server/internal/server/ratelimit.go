@@ -0,0 +1,171 @@
+package server
+
+import (
+	"server/internal/server/config"
+	"server/internal/server/logging"
+	"server/internal/server/metrics"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// What the read pump should do with a packet, see RateLimiter.Check
+type RateLimitAction int
+
+const (
+	RateLimitAllow RateLimitAction = iota // Under the limit, process it
+	RateLimitDrop                         // Over the limit, drop it silently
+	RateLimitWarn                         // Over the limit for a while, drop it and tell the player to slow down
+	RateLimitKick                         // Still flooding after the warning, drop it and call Hub.KickForFlooding
+)
+
+const rateLimitWarning string = "You are sending too many requests, slow down or you will be disconnected"
+const rateLimitKickReason string = "Too many requests"
+const rateLimitBanReason string = "Flooding the server"
+
+// Holds up to burst tokens, every packet takes one and they come back at rate per second
+type tokenBucket struct {
+	tokens float64
+	rate   float64
+	burst  float64
+	last   time.Time
+}
+
+func (b *tokenBucket) take(now time.Time) bool {
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Token buckets per packet type for a single connection, checked by its read pump before the packet
+// reaches the processing channel, so a flood doesn't push the player's real packets out of it.
+// Dropped packets are counted over a window and escalate from dropping to a warning to a kick.
+// Not safe for concurrent use, only the read pump touches it.
+type RateLimiter struct {
+	config      *config.RateLimitConfig
+	buckets     map[string]*tokenBucket // Created on the first packet of each type
+	windowStart time.Time
+	violations  int  // Packets dropped since windowStart
+	warned      bool // Warned during this window
+	kicked      bool // Everything is dropped once we asked for a kick
+}
+
+// Returns nil when rate limiting is disabled, a nil limiter allows everything
+func NewRateLimiter(config *config.RateLimitConfig) *RateLimiter {
+	if !config.Enabled {
+		return nil
+	}
+	return &RateLimiter{
+		config:  config,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// Takes a token for this packet type and tells the read pump what to do with the packet
+func (l *RateLimiter) Check(packetType string, now time.Time) RateLimitAction {
+	if l == nil {
+		return RateLimitAllow
+	}
+	if l.kicked {
+		return RateLimitDrop
+	}
+
+	bucket, exists := l.buckets[packetType]
+	if !exists {
+		limit, found := l.config.Packets[packetType]
+		if !found {
+			limit = l.config.Default
+		}
+		// Starts full, a client that just connected can send its first burst right away
+		bucket = &tokenBucket{tokens: float64(limit.Burst), rate: limit.Rate, burst: float64(limit.Burst), last: now}
+		l.buckets[packetType] = bucket
+	}
+	if bucket.take(now) {
+		return RateLimitAllow
+	}
+
+	// Over the limit, a fresh window forgets the old violations and the warning
+	if now.Sub(l.windowStart) > l.config.Window.Duration {
+		l.windowStart = now
+		l.violations = 0
+		l.warned = false
+	}
+	l.violations++
+
+	switch {
+	case l.config.KickAfter > 0 && l.violations >= l.config.KickAfter:
+		l.kicked = true
+		return RateLimitKick
+	case !l.warned && l.violations >= l.config.WarnAfter:
+		l.warned = true
+		return RateLimitWarn
+	default:
+		return RateLimitDrop
+	}
+}
+
+// Tells the player to slow down, called from the client's read pump. The read pump can still finish a
+// buffered message after the write pump closed the client, SendPacket just drops the warning then
+func (h *Hub) WarnForFlooding(client Client, packetType string) {
+	h.logger.Warn("Client is flooding, warned", logging.ClientId, client.GetId(), logging.Username, client.GetAccountUsername(), logging.PacketType, packetType)
+	metrics.RateLimitActions.Inc("warn")
+	client.SendPacket(packets.NewAnnouncement(rateLimitWarning))
+}
+
+// Kicks a client that kept flooding after the warning. Accounts kicked ban_after times within
+// ban_window are banned for ban_duration, clients that never logged in can only be kicked.
+// Called from the client's read pump, never from the hub goroutine. The kick closes the client
+// later from a timer, the read pump keeps dropping everything until then (see RateLimiter.Check)
+func (h *Hub) KickForFlooding(client Client, packetType string) {
+	err := h.Do(func() {
+		// It may have disconnected while we waited for the hub
+		if _, connected := h.Clients.Get(client.GetId()); !connected {
+			return
+		}
+
+		limits := &h.Config.RateLimit
+		username := client.GetAccountUsername()
+		h.logger.Warn("Client kept flooding, kicking", logging.ClientId, client.GetId(), logging.Username, username, logging.PacketType, packetType)
+		metrics.RateLimitActions.Inc("kick")
+		if username == "" || limits.BanAfter == 0 {
+			h.kick(client, rateLimitKickReason)
+			return
+		}
+
+		// Only the kicks within the ban window count
+		now := time.Now()
+		kicks := []time.Time{now}
+		for _, kickedAt := range h.floodKicks[username] {
+			if now.Sub(kickedAt) < limits.BanWindow.Duration {
+				kicks = append(kicks, kickedAt)
+			}
+		}
+		h.floodKicks[username] = kicks
+		if len(kicks) < limits.BanAfter {
+			h.kick(client, rateLimitKickReason)
+			return
+		}
+
+		delete(h.floodKicks, username)
+		restriction, err := h.Restrict(username, objects.RESTRICTION_BAN, rateLimitBanReason, limits.BanDuration.Duration)
+		if err != nil {
+			h.logger.Error("Failed to ban flooding account, only kicking", logging.Username, username, "error", err)
+			h.kick(client, rateLimitKickReason)
+			return
+		}
+		h.logger.Warn("Banned flooding account", logging.Username, username, "kicks", len(kicks), "duration", limits.BanDuration)
+		metrics.RateLimitActions.Inc("ban")
+		h.kick(client, restriction.Describe(now))
+	})
+
+	// The hub is too busy to take the command, a flooding client isn't worth a Kicked packet.
+	// Close only runs once and does its own cleanup on the hub, so it can't race the kick or the pumps
+	if err != nil {
+		h.logger.Warn("Hub too busy to kick flooding client, disconnecting it", logging.ClientId, client.GetId(), "error", err)
+		client.Close("was kicked: " + rateLimitKickReason)
+	}
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"server/internal/server/logging"
+	"server/pkg/packets"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+)
+
+// Extension used for the session recordings
+const RecordingExtension string = ".rec"
+
+// Writes every packet a client sends or receives to its own file, so we can replay a session
+// when a player reports a desync. The file is a sequence of RecordedPacket messages, each one
+// prefixed by its size as a varint (the standard protobuf delimited format).
+// Every method is safe to call on a nil recorder, that's what clients get when recording is off.
+type Recorder struct {
+	file   *os.File
+	writer *bufio.Writer
+	state  string     // Name of the client's current state, stamped on every packet
+	mutex  sync.Mutex // Inbound and outbound packets are recorded from different goroutines
+	failed bool       // After a write error we stop recording instead of spamming the log
+	logger *slog.Logger
+}
+
+// Records every client session into this folder from now on
+func (h *Hub) EnableRecording(folder string) error {
+	if err := os.MkdirAll(folder, 0o755); err != nil {
+		return fmt.Errorf("creating recordings folder: %w", err)
+	}
+	h.recordingsFolder = folder
+	return nil
+}
+
+// Opens a new recording for this client, returns nil if recording is disabled or the file can't be created
+func (h *Hub) StartRecording(clientId uint64) *Recorder {
+	if h.recordingsFolder == "" {
+		return nil
+	}
+
+	// Client IDs get reused, the timestamp keeps every session in its own file
+	name := fmt.Sprintf("%s_client%d%s", time.Now().Format("20060102_150405.000"), clientId, RecordingExtension)
+	file, err := os.Create(filepath.Join(h.recordingsFolder, name))
+	if err != nil {
+		logging.For(logging.Recorder).Error("Failed to start recording", logging.ClientId, clientId, "error", err)
+		return nil
+	}
+
+	return &Recorder{
+		file:   file,
+		writer: bufio.NewWriter(file),
+		state:  "None",
+		logger: logging.For(logging.Recorder).With(logging.ClientId, clientId, "file", file.Name()),
+	}
+}
+
+// Updates the state name stamped on the following packets
+func (r *Recorder) SetState(state string) {
+	if r == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.state = state
+}
+
+// Appends a packet to the recording, outbound is true for packets the server sends
+func (r *Recorder) Record(clientId uint64, outbound bool, packet *packets.Packet) {
+	if r == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.failed {
+		return
+	}
+
+	record := &packets.RecordedPacket{
+		Timestamp: time.Now().UnixNano(),
+		ClientId:  clientId,
+		State:     r.state,
+		Outbound:  outbound,
+		Packet:    redactPacket(packet),
+	}
+
+	// Buffered, the file is only guaranteed to be complete after Close()
+	if _, err := protodelim.MarshalTo(r.writer, record); err != nil {
+		r.logger.Error("Failed to record packet, recording stopped", logging.State, r.state, logging.PacketType, packets.TypeName(packet.Payload), "error", err)
+		r.failed = true
+	}
+}
+
+// Flushes and closes the recording
+func (r *Recorder) Close() {
+	if r == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.writer.Flush(); err != nil {
+		r.logger.Error("Failed to flush recording", "error", err)
+	}
+	if err := r.file.Close(); err != nil {
+		r.logger.Error("Failed to close recording", "error", err)
+	}
+	r.failed = true // Nothing else can be written after this
+}
+
+// Reads every packet from a recording, a truncated last packet (server crashed) is ignored
+func ReadRecording(path string) ([]*packets.RecordedPacket, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening recording: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	records := []*packets.RecordedPacket{}
+	for {
+		record := &packets.RecordedPacket{}
+		err := protodelim.UnmarshalFrom(reader, record)
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			logging.For(logging.Recorder).Warn("Recording ends with a truncated packet, ignoring it", "file", path)
+			return records, nil
+		}
+		if err != nil {
+			return records, fmt.Errorf("reading packet %d: %w", len(records)+1, err)
+		}
+		records = append(records, record)
+	}
+}
+
+// Passwords never end up on disk, the replay tool fills them back in
+func redactPacket(packet *packets.Packet) *packets.Packet {
+	switch packet.Payload.(type) {
+	case *packets.Packet_LoginRequest, *packets.Packet_RegisterRequest:
+		redacted := proto.Clone(packet).(*packets.Packet)
+		if login := redacted.GetLoginRequest(); login != nil {
+			login.Password = ""
+		}
+		if register := redacted.GetRegisterRequest(); register != nil {
+			register.Password = ""
+		}
+		return redacted
+	}
+	return packet
+}
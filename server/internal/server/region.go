@@ -2,12 +2,13 @@ package server
 
 import (
 	"fmt"
-	"log"
-	"math/rand"
+	"log/slog"
 	"server/internal/server/adt"
+	"server/internal/server/logging"
 	"server/internal/server/objects"
 	"server/internal/server/pathfinding"
 	"server/pkg/packets"
+	"time"
 )
 
 // Respawner represents a spawn point with position and rotation
@@ -42,7 +43,38 @@ type Region struct {
 	// List of spawn points where players can respawn
 	Respawners []*Respawner
 
-	logger *log.Logger
+	// Respawn delay, spawn protection and what is restored on respawn
+	RespawnRules RespawnRules
+
+	// Props that block movement and give cover
+	Obstacles []*Obstacle
+
+	// Items lying on the ground of this region
+	GroundItems *adt.MapMutex[*objects.GroundItem]
+
+	// Random items that appear on the ground over time (nil if this region has none)
+	LootTable     *LootTable
+	nextLootSpawn time.Time
+
+	// Areas that hurt the players standing in them
+	HazardZones []*objects.HazardZone
+
+	// Characters controlled by the server, keyed by NPC ID
+	NPCs *adt.MapMutex[*objects.NPC]
+
+	// NPCs placed in this region when it is created
+	NpcSpawns []*NpcSpawn
+
+	// Areas that keep NPCs alive and bring them back after they die
+	NpcSpawners []*NpcSpawner
+
+	// Deathmatch or team deathmatch running in this region (nil for free-for-all)
+	Match *objects.Match
+
+	// Hub that created this region, used to save data that outlives the region (match results)
+	hub *Hub
+
+	logger *slog.Logger
 }
 
 // Returns this region's ID
@@ -53,9 +85,8 @@ func (r *Region) GetId() uint64 {
 // Sets the ID for this region after creation
 func (r *Region) SetId(id uint64) {
 	r.Id = id
-	// Improve the details of the logged data in the server console
-	prefix := fmt.Sprintf("[%s]: ", r.Name)
-	r.logger.SetPrefix(prefix)
+	// Every log line of this region carries its ID from now on
+	r.logger = logging.For(logging.Regions).With("region", r.Name, logging.RegionId, id)
 }
 
 // Region get/set
@@ -75,7 +106,10 @@ func CreateRegion(name string, gameMap string, gridWidth uint64, gridHeight uint
 		AddClientChannel:    make(chan Client),
 		RemoveClientChannel: make(chan Client),
 		grid:                *pathfinding.CreateGrid(gridWidth, gridHeight),
-		logger:              log.New(log.Writer(), "", log.LstdFlags),
+		GroundItems:         adt.NewMapMutex[*objects.GroundItem](),
+		NPCs:                adt.NewMapMutex[*objects.NPC](),
+		RespawnRules:        DefaultRespawnRules,
+		logger:              logging.For(logging.Regions).With("region", name),
 	}
 }
 
@@ -83,7 +117,7 @@ func CreateRegion(name string, gameMap string, gridWidth uint64, gridHeight uint
 func (r *Region) Start() {
 	// Log into the console which region this is and whats its grid size
 	grid := r.GetGrid()
-	r.logger.Printf("%s region [%d] created (%dx%d)...", r.Name, r.GetId(), grid.GetMaxWidth(), grid.GetMaxHeight())
+	r.logger.Info("Region created", "width", grid.GetMaxWidth(), "height", grid.GetMaxHeight())
 
 	// Infinite for loop
 	for {
@@ -122,18 +156,59 @@ func (r *Region) Start() {
 	}
 }
 
+// Updates the timed systems of this region, called every tick by the hub
+func (r *Region) Update(now time.Time) {
+	r.updateGroundItems(now)
+	r.updateItemUses(now)
+	r.updateStatusEffects(now)
+	r.updateHazards(now)
+	r.updateNpcSpawners(now)
+	r.updateNPCs(now)
+	r.updateMatch(now)
+}
+
 // Retrieves the client (if found) in the Clients collection
 func (r *Region) GetClient(id uint64) (Client, bool) {
 	return r.Clients.Get(id)
 }
 
+// Returns the name of a player or NPC of this region for the kill feed (empty for the environment)
+func (r *Region) getAttackerName(attackerId uint64) string {
+	if objects.IsNpcId(attackerId) {
+		if npc, exists := r.NPCs.Get(attackerId); exists {
+			return npc.Name
+		}
+		return ""
+	}
+	if client, exists := r.GetClient(attackerId); exists {
+		return client.GetPlayerCharacter().Name
+	}
+	return ""
+}
+
+// Rewards a player with experience and tells them when they reach a new level
+func (r *Region) GiveExperience(client Client, amount uint64) {
+	player := client.GetPlayerCharacter()
+	if !player.AddExperience(amount) {
+		return
+	}
+	r.logger.Info("Player leveled up", logging.Username, client.GetAccountUsername(), "level", player.Level)
+	client.SendPacket(packets.NewAnnouncement(fmt.Sprintf("You reached level %d!", player.Level)))
+}
+
 // Checks if the desired coordinate is in the respawners list
 // If yes, use that coordinate
-// If no, choose a random respawner from the list
+// If no, choose the safest respawner from the list
 // If no respawners are defined, fall back to (0,0)
 // Then find the nearest available cell from the chosen coordinate
 func (r *Region) RespawnPlayer(client Client, desiredPosition *pathfinding.Cell) error {
 	player := client.GetPlayerCharacter()
+
+	// Dead players wait for the respawn delay of the region (or the match)
+	if respawnAt := r.getRespawnTime(client); time.Now().Before(respawnAt) {
+		return fmt.Errorf("respawn available in %d seconds", int(time.Until(respawnAt).Seconds())+1)
+	}
+
 	// Get the player's current position
 	deathPosition := player.GetGridPosition()
 
@@ -159,13 +234,11 @@ func (r *Region) RespawnPlayer(client Client, desiredPosition *pathfinding.Cell)
 		}
 	}
 
-	// If desired position is not valid or not provided, choose a random respawner
+	// If desired position is not valid or not provided, choose the one furthest from enemies
 	if respawnCell == nil {
 		// If this region has valid spawners
 		if len(r.Respawners) > 0 {
-			// Choose a random respawner
-			randIndex := rand.Intn(len(r.Respawners))
-			respawner := r.Respawners[randIndex]
+			respawner := r.getSafestRespawner(client)
 			respawnCell = respawner.Position
 			respawnRotation = respawner.Rotation
 		} else {
@@ -182,8 +255,8 @@ func (r *Region) RespawnPlayer(client Client, desiredPosition *pathfinding.Cell)
 		return fmt.Errorf("no respawn location available in region %d", r.GetId())
 	}
 
-	// Reset player stats, position and rotation
-	player.Respawn(respawnRotation)
+	// Reset player stats, position and rotation, the region decides what is restored
+	player.Respawn(respawnRotation, r.RespawnRules.HealthPercent, r.RespawnRules.RefillAmmo)
 
 	// Place player at respawn location
 	grid.SetObject(playerSpawnCell, player)
@@ -197,7 +270,53 @@ func (r *Region) RespawnPlayer(client Client, desiredPosition *pathfinding.Cell)
 	client.SendPacket(respawnPacket)
 	client.Broadcast(respawnPacket)
 
-	r.logger.Printf("Player %s respawned at (%d, %d)", player.Name, playerSpawnCell.X, playerSpawnCell.Z)
+	r.startSpawnProtection(client)
+
+	r.logger.Info("Player respawned", logging.ClientId, client.GetId(), logging.Username, client.GetAccountUsername(), "x", playerSpawnCell.X, "z", playerSpawnCell.Z)
 
 	return nil
 }
+
+// Handles a character whose health reached zero
+// The attacker ID is 0 for environment deaths, only the killer character can loot the dropped items for a while
+// The cause is the weapon, hazard or status effect that killed them, shown in the kill feed
+func (r *Region) KillPlayer(target Client, attackerId uint64, killerCharacterId int64, cause string, isCritical bool) {
+	player := target.GetPlayerCharacter()
+
+	// Remove target player from server grid immediately (both, current position and destination)
+	grid := r.GetGrid()
+	grid.SetObject(player.GetGridPosition(), nil)
+	grid.SetObject(player.GetGridDestination(), nil)
+
+	// Dead characters don't keep using items or suffering effects
+	r.CancelItemUse(target, "death")
+	player.ClearStatusEffects()
+
+	// Tell everyone this player died
+	playerDiedPacket := packets.NewPlayerDied(attackerId, target.GetId())
+	target.SendPacket(playerDiedPacket)
+	target.Broadcast(playerDiedPacket)
+
+	// Record the death and the kill, killing yourself doesn't count as a kill
+	player.GetUnsavedStats().Deaths++
+	if attacker, exists := r.GetClient(attackerId); exists && attackerId != target.GetId() {
+		attacker.GetPlayerCharacter().GetUnsavedStats().RecordKill(cause, false)
+		r.GiveExperience(attacker, objects.EXPERIENCE_PER_KILL)
+	}
+	r.SendToAll(packets.NewKillFeed(attackerId, r.getAttackerName(attackerId), target.GetId(), player.Name, cause, isCritical))
+
+	// Drop everything the target was carrying
+	r.DropInventory(player, player.GetGridPosition(), killerCharacterId)
+	target.SendPacket(packets.NewInventorySnapshot(player.GetInventory()))
+
+	// Score the death and respawn the player on a timer if this region runs a match
+	if r.Match != nil {
+		r.recordMatchKill(attackerId, target.GetId())
+		r.SendRespawnOptions(target)
+		return
+	}
+
+	// The player stays dead until the respawn delay is over and they send a RespawnRequest packet
+	player.SetRespawnTime(time.Now().Add(r.RespawnRules.Delay))
+	r.SendRespawnOptions(target)
+}
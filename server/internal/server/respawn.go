@@ -0,0 +1,116 @@
+package server
+
+import (
+	"math/rand"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+const respawnSafeDistance uint64 = 10 // Respawners with no enemy closer than this are completely safe
+
+// What happens when the players of a region die and come back
+type RespawnRules struct {
+	Delay         time.Duration // Time dead players wait before they can respawn (matches use their own delay)
+	Protection    time.Duration // Attacks can't hurt players for this long after respawning, firing ends it early
+	HealthPercent uint64        // Health players come back with, percent of their max health
+	RefillAmmo    bool          // Fill the magazine and reserve of every weapon
+}
+
+// Rules of the regions that don't set their own
+var DefaultRespawnRules = RespawnRules{
+	Delay:         5 * time.Second,
+	Protection:    3 * time.Second,
+	HealthPercent: 100,
+	RefillAmmo:    true,
+}
+
+// Returns when this client can respawn (zero if they don't have to wait), matches keep their own timer
+func (r *Region) getRespawnTime(client Client) time.Time {
+	if r.Match != nil {
+		return r.Match.GetRespawnTime(client.GetId())
+	}
+	return client.GetPlayerCharacter().GetRespawnTime()
+}
+
+// Returns how safe a respawner is for this client, from 0 (an enemy is on it) to 100 (no enemies in range)
+// Players we can't damage (teammates) don't count as enemies, NPCs always do
+func (r *Region) getRespawnerSafety(client Client, respawner *Respawner) uint64 {
+	nearest := respawnSafeDistance
+
+	r.Clients.ForEach(func(id uint64, other Client) {
+		if id == client.GetId() || (r.Match != nil && !r.Match.CanDamage(client.GetId(), id)) {
+			return
+		}
+		player := other.GetPlayerCharacter()
+		if player == nil || !player.IsAlive() || player.GetGridPosition() == nil {
+			return
+		}
+		nearest = min(nearest, objects.CellDistance(respawner.Position, player.GetGridPosition()))
+	})
+
+	r.NPCs.ForEach(func(id uint64, npc *objects.NPC) {
+		if npc.IsAlive() && npc.GetGridPosition() != nil {
+			nearest = min(nearest, objects.CellDistance(respawner.Position, npc.GetGridPosition()))
+		}
+	})
+
+	return nearest * 100 / respawnSafeDistance
+}
+
+// Returns the safest respawner for this client, a random one among the safest if there's a tie
+func (r *Region) getSafestRespawner(client Client) *Respawner {
+	var safest []*Respawner
+	var bestSafety uint64 = 0
+	for _, respawner := range r.Respawners {
+		safety := r.getRespawnerSafety(client, respawner)
+		if len(safest) == 0 || safety > bestSafety {
+			safest = []*Respawner{respawner}
+			bestSafety = safety
+		} else if safety == bestSafety {
+			safest = append(safest, respawner)
+		}
+	}
+
+	if len(safest) == 0 {
+		return nil
+	}
+	return safest[rand.Intn(len(safest))]
+}
+
+// Tells a dead client when they can respawn and how safe each respawner is, so they can pick one
+func (r *Region) SendRespawnOptions(client Client) {
+	var respawners []*packets.RespawnPoint
+	for _, respawner := range r.Respawners {
+		respawners = append(respawners, packets.NewRespawnPoint(respawner.Position, respawner.Rotation, r.getRespawnerSafety(client, respawner)))
+	}
+
+	timeLeft := max(time.Until(r.getRespawnTime(client)), 0)
+	// Matches respawn their players on their own
+	client.SendPacket(packets.NewRespawnOptions(timeLeft, r.Match != nil, respawners))
+}
+
+// Protects a player that just respawned from attacks for a while
+func (r *Region) startSpawnProtection(client Client) {
+	if r.RespawnRules.Protection <= 0 {
+		return
+	}
+	client.GetPlayerCharacter().SetSpawnProtection(time.Now().Add(r.RespawnRules.Protection))
+
+	spawnProtectionPacket := packets.NewSpawnProtection(client.GetId(), r.RespawnRules.Protection)
+	client.SendPacket(spawnProtectionPacket)
+	client.Broadcast(spawnProtectionPacket)
+}
+
+// Ends the spawn protection of a player early, called when they fire
+func (r *Region) EndSpawnProtection(client Client) {
+	player := client.GetPlayerCharacter()
+	if !player.IsSpawnProtected(time.Now()) {
+		return
+	}
+	player.SetSpawnProtection(time.Time{})
+
+	spawnProtectionPacket := packets.NewSpawnProtection(client.GetId(), 0)
+	client.SendPacket(spawnProtectionPacket)
+	client.Broadcast(spawnProtectionPacket)
+}
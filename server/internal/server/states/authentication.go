@@ -3,8 +3,9 @@ package states
 import (
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"server/internal/server"
+	"server/internal/server/logging"
 	"server/internal/server/objects"
 	"strings"
 	"time"
@@ -14,11 +15,9 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-const disconnectTimeout time.Duration = 2 // 2 minutes
-
 type Authentication struct {
 	client          server.Client
-	logger          *log.Logger
+	logger          *slog.Logger
 	lastActivity    time.Time   // Track last activity
 	inactivityTimer *time.Timer // Disconnects player due to inactivity
 }
@@ -31,17 +30,16 @@ func (state *Authentication) SetClient(client server.Client) {
 	// We save the client's data into this state
 	state.client = client
 
-	// Logging data in the server console
-	prefix := fmt.Sprintf("Client %d [%s]: ", client.GetId(), state.GetName())
-	state.logger = log.New(log.Writer(), prefix, log.LstdFlags)
+	// Every log line of this state carries the client and state fields
+	state.logger = logging.For(logging.States).With(logging.ClientId, client.GetId(), logging.State, state.GetName())
 }
 
 func (state *Authentication) OnEnter() {
 	// Keep track of last activity time
 	state.lastActivity = time.Now()
 
-	// Create a timer that will disconnect after two minutes
-	state.inactivityTimer = time.AfterFunc(disconnectTimeout*time.Minute, func() {
+	// Create a timer that will disconnect after a while (two minutes by default)
+	state.inactivityTimer = time.AfterFunc(state.client.GetHub().Config.DisconnectTimeout.Duration, func() {
 		// Check that our client hasn't disconnected already
 		if state.client != nil {
 			state.client.Close("authentication timeout")
@@ -64,7 +62,7 @@ func (state *Authentication) HandlePacket(senderId uint64, payload packets.Paylo
 			if !state.inactivityTimer.Stop() {
 				return
 			}
-			state.inactivityTimer.Reset(disconnectTimeout * time.Minute)
+			state.inactivityTimer.Reset(state.client.GetHub().Config.DisconnectTimeout.Duration)
 		}
 
 		// Switch based on the type of packet
@@ -94,7 +92,7 @@ func (state *Authentication) HandlePacket(senderId uint64, payload packets.Paylo
 func (state *Authentication) HandleLoginRequest(senderId uint64, payload *packets.LoginRequest) {
 	// If client used a different ID than his own ID, ignore this packet
 	if senderId != state.client.GetId() {
-		state.logger.Printf("Unauthorized login packet: ID#%d != ID#%d", senderId, state.client.GetId())
+		state.logger.Warn("Unauthorized login packet", "sender_id", senderId)
 		return
 	}
 
@@ -105,7 +103,7 @@ func (state *Authentication) HandleLoginRequest(senderId uint64, payload *packet
 	err := validateUsername(username)
 	if err != nil {
 		reason := fmt.Sprintf("Invalid username: %v", err)
-		state.logger.Println(reason)
+		state.logger.Info("Login denied", "reason", reason)
 		state.client.SendPacket(packets.NewRequestDenied(reason))
 		return
 	}
@@ -117,7 +115,7 @@ func (state *Authentication) HandleLoginRequest(senderId uint64, payload *packet
 	err = validatePassword(password)
 	if err != nil {
 		reason := fmt.Sprintf("Invalid password: %v", err)
-		state.logger.Println(reason)
+		state.logger.Info("Login denied", logging.Username, username, "reason", reason)
 		state.client.SendPacket(packets.NewRequestDenied(reason))
 		return
 	}
@@ -128,7 +126,7 @@ func (state *Authentication) HandleLoginRequest(senderId uint64, payload *packet
 	// Check if the username exists in the database (case insensitive)
 	user, err := state.client.GetHub().GetUserByUsername(username)
 	if err != nil {
-		state.logger.Printf("Username %s error: %v", username, err)
+		state.logger.Info("Login denied, unknown username", logging.Username, username, "error", err)
 		state.client.SendPacket(deniedMessage)
 		return
 	}
@@ -136,14 +134,35 @@ func (state *Authentication) HandleLoginRequest(senderId uint64, payload *packet
 	// If the username exists, we compare the passwords to see if they match
 	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
 	if err != nil {
-		state.logger.Printf("Invalid password attempt from %s", user.Nickname)
+		state.logger.Warn("Invalid password attempt", logging.Username, username)
 		state.client.SendPacket(deniedMessage)
 		return
 	}
 
+	// Banned accounts can't log in until the ban expires
+	ban, err := state.client.GetHub().GetRestriction(user.ID, objects.RESTRICTION_BAN)
+	if err != nil {
+		state.logger.Error("Failed to check ban", logging.Username, username, "error", err)
+		state.client.SendPacket(packets.NewRequestDenied("Error loading account from database"))
+		return
+	}
+	if ban != nil {
+		state.logger.Info("Banned account tried to log in", logging.Username, username)
+		state.client.SendPacket(packets.NewRequestDenied(ban.Describe(time.Now())))
+		return
+	}
+
+	// Muted players can log in, the mute is kept in their character
+	mute, err := state.client.GetHub().GetRestriction(user.ID, objects.RESTRICTION_MUTE)
+	if err != nil {
+		state.logger.Error("Failed to check mute", logging.Username, username, "error", err)
+		state.client.SendPacket(packets.NewRequestDenied("Error loading account from database"))
+		return
+	}
+
 	// Make sure the account is not already connected to a region (logged in)
 	if state.client.GetHub().IsAlreadyConnected(username) {
-		state.logger.Printf("%s is already logged in", user.Nickname)
+		state.logger.Info("Account is already logged in", logging.Username, username)
 		// We override the denied message
 		deniedMessage = packets.NewRequestDenied("Account already connected")
 		state.client.SendPacket(deniedMessage)
@@ -168,9 +187,23 @@ func (state *Authentication) HandleLoginRequest(senderId uint64, payload *packet
 		return
 	}
 
-	// TO FIX -> Load all of this from the database [eventually!]
-	var level uint64 = 1
-	var experience uint64 = 1
+	inventory, err := state.client.GetHub().LoadInventory(character.ID)
+	if err != nil {
+		deniedMessage = packets.NewRequestDenied("Error loading inventory from database")
+		state.client.SendPacket(deniedMessage)
+		return
+	}
+
+	armor, err := state.client.GetHub().LoadArmor(character.ID)
+	if err != nil {
+		deniedMessage = packets.NewRequestDenied("Error loading armor from database")
+		state.client.SendPacket(deniedMessage)
+		return
+	}
+
+	// The level always follows the experience, even if the level curve changed since the last save
+	var experience uint64 = uint64(character.Experience)
+	var level uint64 = objects.LevelForExperience(experience)
 
 	// Validate some data before setting the player
 	var health uint64 = uint64(character.Health)
@@ -192,14 +225,19 @@ func (state *Authentication) HandleLoginRequest(senderId uint64, payload *packet
 		uint64(character.WeaponSlot),
 		*weapons,
 		// Stats
-		level, experience, // TO FIX -> Load this from the database too!
+		level, experience,
 		// Atributes
 		health,
 		maxHealth,
 		isCrouching,
 	))
+	state.client.GetPlayerCharacter().SetInventory(inventory)
+	state.client.GetPlayerCharacter().SetMute(mute)
+	for slot, piece := range armor {
+		state.client.GetPlayerCharacter().SetArmor(uint64(slot), piece)
+	}
 
-	state.logger.Printf("%s logged in as %s", username, user.Nickname)
+	state.logger.Info("Logged in", logging.Username, username, "nickname", user.Nickname)
 	// We send the nickname to the client so he can display it on his own game client
 	state.client.SendPacket(packets.NewLoginSuccess(user.Nickname))
 	// After the client logs in, switch to the Game state
@@ -209,7 +247,7 @@ func (state *Authentication) HandleLoginRequest(senderId uint64, payload *packet
 func (state *Authentication) HandleRegisterRequest(senderId uint64, payload *packets.RegisterRequest) {
 	// If client used a different ID than his own ID, ignore this packet
 	if senderId != state.client.GetId() {
-		state.logger.Printf("Unauthorized register packet: ID#%d != ID#%d", senderId, state.client.GetId())
+		state.logger.Warn("Unauthorized register packet", "sender_id", senderId)
 		return
 	}
 
@@ -221,7 +259,7 @@ func (state *Authentication) HandleRegisterRequest(senderId uint64, payload *pac
 	err := validateUsername(username)
 	if err != nil {
 		reason := fmt.Sprintf("Invalid username: %v", err)
-		state.logger.Println(reason)
+		state.logger.Info("Registration denied", logging.Username, username, "reason", reason)
 		state.client.SendPacket(packets.NewRequestDenied(reason))
 		return
 	}
@@ -231,7 +269,7 @@ func (state *Authentication) HandleRegisterRequest(senderId uint64, payload *pac
 	// If we DIDN'T FIND an error, then we found a user, that means username is already in use
 	if err == nil {
 		reason := fmt.Sprintf("Username %s already in use", username)
-		state.logger.Println(reason)
+		state.logger.Info("Registration denied", logging.Username, username, "reason", reason)
 		state.client.SendPacket(packets.NewRequestDenied(reason))
 		return
 	}
@@ -243,7 +281,7 @@ func (state *Authentication) HandleRegisterRequest(senderId uint64, payload *pac
 	err = validateNickname(nickname)
 	if err != nil {
 		reason := fmt.Sprintf("Invalid nickname: %v", err)
-		state.logger.Println(reason)
+		state.logger.Info("Registration denied", logging.Username, username, "reason", reason)
 		state.client.SendPacket(packets.NewRequestDenied(reason))
 		return
 	}
@@ -252,7 +290,7 @@ func (state *Authentication) HandleRegisterRequest(senderId uint64, payload *pac
 	nickname, err = capitalize(nickname)
 	if err != nil {
 		reason := fmt.Sprintf("Invalid nickname: %v", err)
-		state.logger.Println(reason)
+		state.logger.Info("Registration denied", logging.Username, username, "reason", reason)
 		state.client.SendPacket(packets.NewRequestDenied(reason))
 		return
 	}
@@ -262,7 +300,7 @@ func (state *Authentication) HandleRegisterRequest(senderId uint64, payload *pac
 	// If we DIDN'T FIND an error, then we found a user, that means nickname is already in use
 	if err == nil {
 		reason := fmt.Sprintf("Nickname %s already in use", nickname)
-		state.logger.Println(reason)
+		state.logger.Info("Registration denied", logging.Username, username, "reason", reason)
 		state.client.SendPacket(packets.NewRequestDenied(reason))
 		return
 	}
@@ -274,7 +312,7 @@ func (state *Authentication) HandleRegisterRequest(senderId uint64, payload *pac
 	err = validatePassword(password)
 	if err != nil {
 		reason := fmt.Sprintf("Invalid password: %v", err)
-		state.logger.Println(reason)
+		state.logger.Info("Registration denied", logging.Username, username, "reason", reason)
 		state.client.SendPacket(packets.NewRequestDenied(reason))
 		return
 	}
@@ -285,9 +323,9 @@ func (state *Authentication) HandleRegisterRequest(senderId uint64, payload *pac
 	deniedMessage := packets.NewRequestDenied("Error registering user (internal server error)")
 
 	// Attempt to hash the password
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(payload.Password), bcrypt.DefaultCost)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(payload.Password), state.client.GetHub().Config.BcryptCost)
 	if err != nil {
-		state.logger.Printf("Error registering user (Bcrypt failure)")
+		state.logger.Error("Error registering user (Bcrypt failure)", logging.Username, username, "error", err)
 		state.client.SendPacket(deniedMessage)
 		return
 	}
@@ -306,14 +344,14 @@ func (state *Authentication) HandleRegisterRequest(senderId uint64, payload *pac
 			}
 		} else {
 			reason = "Internal server error"
-			state.logger.Printf("Registration error :%v", err)
+			state.logger.Error("Registration error", logging.Username, username, "error", err)
 		}
 
 		state.client.SendPacket(packets.NewRequestDenied(reason))
 		return
 	}
 
-	state.logger.Printf("New user %s registered successfully (ID: %d)", nickname, user.ID)
+	state.logger.Info("New user registered", logging.Username, username, "nickname", nickname, "user_id", user.ID)
 	state.client.SendPacket(packets.NewRequestGranted())
 }
 
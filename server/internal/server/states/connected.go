@@ -1,17 +1,17 @@
 package states
 
 import (
-	"fmt"
-	"log"
+	"log/slog"
 	"server/internal/server"
 	"server/internal/server/info"
+	"server/internal/server/logging"
 
 	"server/pkg/packets"
 )
 
 type Connected struct {
 	client server.Client
-	logger *log.Logger
+	logger *slog.Logger
 }
 
 func (state *Connected) GetName() string {
@@ -22,9 +22,8 @@ func (state *Connected) SetClient(client server.Client) {
 	// We save the client's data into this state
 	state.client = client
 
-	// Logging data in the server console
-	prefix := fmt.Sprintf("Client %d [%s]: ", client.GetId(), state.GetName())
-	state.logger = log.New(log.Writer(), prefix, log.LstdFlags)
+	// Every log line of this state carries the client and state fields
+	state.logger = logging.For(logging.States).With(logging.ClientId, client.GetId(), logging.State, state.GetName())
 }
 
 func (state *Connected) OnEnter() {
@@ -58,6 +57,8 @@ func (state *Connected) HandleHandshake(payload *packets.Handshake) {
 	if payload.Version == info.Version {
 		// Switch this client to the Authentication state
 		state.client.SetState(&Authentication{})
+	} else {
+		state.logger.Info("Handshake with a different version", "version", payload.Version, "server_version", info.Version)
 	}
 }
 
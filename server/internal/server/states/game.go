@@ -1,34 +1,24 @@
 package states
 
 import (
+	"errors"
 	"fmt"
-	"log"
-	"math/rand"
+	"log/slog"
 	"server/internal/server"
+	"server/internal/server/logging"
 	"server/internal/server/math"
 	"server/internal/server/objects"
 	"server/internal/server/pathfinding"
+	"strings"
 	"time"
 
 	"server/pkg/packets"
 )
 
-// Rolls damage for a weapon
-func getWeaponDamage(weaponName string) uint64 {
-	stats, exists := objects.GetWeaponStats(weaponName)
-	if exists {
-		if stats.MaxDamage > stats.MinDamage {
-			return stats.MinDamage + uint64(rand.Intn(int(stats.MaxDamage-stats.MinDamage)+1))
-		}
-		return stats.MinDamage
-	}
-	return 0
-}
-
 type Game struct {
 	client server.Client
 	player *objects.Player
-	logger *log.Logger
+	logger *slog.Logger
 }
 
 func (state *Game) GetName() string {
@@ -42,9 +32,12 @@ func (state *Game) SetClient(client server.Client) {
 	// We save the client's character data into this state too
 	state.player = client.GetPlayerCharacter()
 
-	// Logging data in the server console
-	prefix := fmt.Sprintf("Client %d [%s]: ", client.GetId(), state.GetName())
-	state.logger = log.New(log.Writer(), prefix, log.LstdFlags)
+	// Every log line of this state carries the client, account and state fields
+	state.logger = logging.For(logging.States).With(
+		logging.ClientId, client.GetId(),
+		logging.Username, client.GetAccountUsername(),
+		logging.State, state.GetName(),
+	)
 }
 
 // Executed automatically when a client leaves the game state
@@ -61,10 +54,15 @@ func (state *Game) OnEnter() {
 	// Add this client's character to the player map of the hub with the same ID as its client ID
 	go state.client.GetHub().SharedObjects.Players.Add(state.player, state.client.GetId())
 
+	// Load our guild before spawning so everyone can see our guild tag
+	if err := state.client.GetHub().LoadGuildMembership(state.client); err != nil {
+		state.logger.Error("Failed to load guild", "error", err)
+	}
+
 	// Move the client to the region his character is at in the database
 	state.client.GetHub().JoinRegion(state.client.GetAccountUsername())
 
-	state.logger.Printf("%s added to region %d", state.player.Name, state.player.GetRegionId())
+	state.logger.Info("Added to region", logging.RegionId, state.player.GetRegionId())
 
 	// Create a spawn packet to be sent to everyone in this region
 	updatePlayerPacket := packets.NewSpawnCharacter(state.client.GetId(), state.player)
@@ -85,6 +83,19 @@ func (state *Game) OnEnter() {
 		updatePlayerPacket := packets.NewSpawnCharacter(id, client.GetPlayerCharacter())
 		state.client.SendPacket(updatePlayerPacket)
 	})
+
+	// Show us the items lying on the ground, the hazards and the NPCs of this region
+	state.client.GetRegion().SendGroundItems(state.client)
+	state.client.GetRegion().SendHazardZones(state.client)
+	state.client.GetRegion().SendNPCs(state.client)
+
+	// Send our friends list and tell our friends we are online
+	state.client.GetHub().SendFriendsList(state.client)
+	state.client.GetHub().NotifyFriends(state.client, true)
+
+	// Send the items we are carrying and the armor we are wearing
+	state.client.SendPacket(packets.NewInventorySnapshot(state.player.GetInventory()))
+	state.client.SendPacket(packets.NewArmorSnapshot(state.player))
 }
 
 // Keeps an accurate representation of the character's position on the server
@@ -107,7 +118,8 @@ func (state *Game) processMovement() {
 		totalSteps := uint64(len(path) - 1) // We subtract one because the first doesn't count
 
 		// We account for our max distance per tick here using Speed
-		stepsRemaining := math.MinimumUint64(totalSteps, state.player.GetSpeed())
+		// Status effects can slow us down
+		stepsRemaining := math.MinimumUint64(totalSteps, state.player.GetEffectiveSpeed())
 
 		// Get the grid from this region
 		grid := state.client.GetRegion().GetGrid()
@@ -127,6 +139,8 @@ func (state *Game) processMovement() {
 					grid.SetObject(nextCell, state.player)
 					// Keep track of our position in our player object
 					state.player.SetGridPosition(nextCell)
+					// Pick up any items lying on this cell
+					state.client.GetRegion().PickupGroundItemsAt(state.client, nextCell)
 					// Calculate new rotation based on movement direction
 					state.player.CalculateRotation(currentCell, nextCell)
 					// We overwrite our path variable to remove the first cell
@@ -136,19 +150,19 @@ func (state *Game) processMovement() {
 
 				} else {
 					// If the next cell is not valid or occupied
-					fmt.Println("Next cell is not valid or occupied")
+					state.logger.Debug("Next cell is not valid or occupied", logging.RegionId, state.player.GetRegionId(), "x", nextCell.X, "z", nextCell.Z)
 					break
 				}
 			} else {
 				// If the next cell is invalid
-				fmt.Println("Next cell is invalid")
+				state.logger.Debug("Next cell is invalid", logging.RegionId, state.player.GetRegionId())
 				break
 			}
 		}
 
 		// If we didn't move
 		if steps == 0 {
-			fmt.Println("We didn't move for some reason, so abort...")
+			state.logger.Debug("We didn't move for some reason, so abort...", logging.RegionId, state.player.GetRegionId())
 			// We forget about our destination since its not reachable
 			state.player.SetGridDestination(state.player.GetGridPosition())
 		}
@@ -259,6 +273,106 @@ func (state *Game) HandlePacket(senderId uint64, payload packets.Payload) {
 		case *packets.Packet_CrouchCharacter:
 			state.HandleCrouchCharacter(casted_payload.CrouchCharacter)
 
+		// FRIEND REQUEST
+		case *packets.Packet_FriendRequest:
+			state.HandleFriendRequest(casted_payload.FriendRequest)
+
+		// FRIEND ACCEPT
+		case *packets.Packet_FriendAccept:
+			state.HandleFriendAccept(casted_payload.FriendAccept)
+
+		// FRIEND REMOVE
+		case *packets.Packet_FriendRemove:
+			state.HandleFriendRemove(casted_payload.FriendRemove)
+
+		// GUILD CREATE
+		case *packets.Packet_GuildCreate:
+			state.HandleGuildCreate(casted_payload.GuildCreate)
+
+		// GUILD DISBAND
+		case *packets.Packet_GuildDisband:
+			state.HandleGuildDisband()
+
+		// GUILD INVITE
+		case *packets.Packet_GuildInvite:
+			state.HandleGuildInvite(casted_payload.GuildInvite)
+
+		// GUILD INVITE RESPONSE
+		case *packets.Packet_GuildInviteResponse:
+			state.HandleGuildInviteResponse(casted_payload.GuildInviteResponse)
+
+		// GUILD LEAVE
+		case *packets.Packet_GuildLeave:
+			state.HandleGuildLeave()
+
+		// GUILD KICK
+		case *packets.Packet_GuildKick:
+			state.HandleGuildKick(casted_payload.GuildKick)
+
+		// GUILD SET RANK
+		case *packets.Packet_GuildSetRank:
+			state.HandleGuildSetRank(casted_payload.GuildSetRank)
+
+		// GUILD EDIT RANK
+		case *packets.Packet_GuildEditRank:
+			state.HandleGuildEditRank(casted_payload.GuildEditRank)
+
+		// GUILD ROSTER REQUEST
+		case *packets.Packet_GuildRosterRequest:
+			state.client.GetHub().SendGuildRoster(state.client)
+
+		// GUILD MESSAGE
+		case *packets.Packet_GuildMessage:
+			state.HandleGuildMessage(casted_payload.GuildMessage)
+
+		// INVENTORY MOVE
+		case *packets.Packet_InventoryMove:
+			state.HandleInventoryMove(casted_payload.InventoryMove)
+
+		// INVENTORY USE
+		case *packets.Packet_InventoryUse:
+			state.HandleInventoryUse(casted_payload.InventoryUse)
+
+		// INVENTORY DROP
+		case *packets.Packet_InventoryDrop:
+			state.HandleInventoryDrop(casted_payload.InventoryDrop)
+
+		// INVENTORY EQUIP
+		case *packets.Packet_InventoryEquip:
+			state.HandleInventoryEquip(casted_payload.InventoryEquip)
+
+		// INVENTORY UNEQUIP
+		case *packets.Packet_InventoryUnequip:
+			state.HandleInventoryUnequip(casted_payload.InventoryUnequip)
+
+		// ARMOR EQUIP
+		case *packets.Packet_ArmorEquip:
+			state.HandleArmorEquip(casted_payload.ArmorEquip)
+
+		// ARMOR UNEQUIP
+		case *packets.Packet_ArmorUnequip:
+			state.HandleArmorUnequip(casted_payload.ArmorUnequip)
+
+		// PICKUP GROUND ITEM
+		case *packets.Packet_PickupGroundItem:
+			state.HandlePickupGroundItem(casted_payload.PickupGroundItem)
+
+		// CANCEL ITEM USE
+		case *packets.Packet_CancelItemUse:
+			state.client.GetRegion().CancelItemUse(state.client, "cancelled")
+
+		// STATS REQUEST
+		case *packets.Packet_StatsRequest:
+			state.HandleStatsRequest(casted_payload.StatsRequest)
+
+		// LEADERBOARD REQUEST
+		case *packets.Packet_LeaderboardRequest:
+			state.HandleLeaderboardRequest(casted_payload.LeaderboardRequest)
+
+		// RESPAWN OPTIONS REQUEST
+		case *packets.Packet_RespawnOptionsRequest:
+			state.HandleRespawnOptionsRequest()
+
 		case nil:
 			// Ignore packet if not a valid payload type
 		default:
@@ -275,7 +389,10 @@ func (state *Game) HandlePacket(senderId uint64, payload packets.Payload) {
 
 // Tell everybody we sent a public message
 func (state *Game) HandlePublicMessage(nickname string, text string) {
-	state.client.Broadcast(packets.NewPublicMessage(nickname, text))
+	if state.isMuted() {
+		return
+	}
+	state.client.Broadcast(packets.NewPublicMessage(nickname, state.client.GetHub().FilterChat(text)))
 }
 
 // We send this message to everybody
@@ -296,12 +413,18 @@ func (state *Game) HandleDestination(payload *packets.Destination) {
 	grid := state.client.GetRegion().GetGrid()
 	// Get the cell the player wants to access
 	destination := grid.LocalToMap(payload.X, payload.Z)
+	// Stunned characters can't move
+	if state.player.IsStunned() {
+		return
+	}
 	// Only update the player's destination if the cell is valid and unoccupied
 	if grid.IsCellReachable(destination) && grid.IsCellAvailable(destination) {
 		// We compare our new destination to our previous one
 		previousDestination := state.player.GetGridDestination()
 		// If the new destination is NOT the same one we already had
 		if previousDestination != destination {
+			// Moving interrupts any item we are using
+			state.client.GetRegion().CancelItemUse(state.client, "movement")
 			// Overwrite the destination
 			state.player.SetGridDestination(destination)
 			// Process movement immediately instead of waiting for tick
@@ -339,7 +462,7 @@ func (state *Game) HandleJoinRegionRequest(payload *packets.JoinRegionRequest) {
 	// MAP_ID SHOULDNT be the same as REGION_ID if I want to use instances
 	hub.SwitchRegion(state.client.GetAccountUsername(), payload.GetRegionId(), payload.GetRegionId())
 
-	state.logger.Printf("%s added to region %d", state.player.Name, state.player.GetRegionId())
+	state.logger.Info("Switched region", logging.RegionId, state.player.GetRegionId())
 
 	// Wait a brief moment to ensure client receives packets
 	time.Sleep(250 * time.Millisecond)
@@ -368,6 +491,14 @@ func (state *Game) HandleJoinRegionRequest(payload *packets.JoinRegionRequest) {
 		spawnCharacterPacket := packets.NewSpawnCharacter(id, client.GetPlayerCharacter())
 		state.client.SendPacket(spawnCharacterPacket)
 	})
+
+	// Show us the items lying on the ground, the hazards and the NPCs of this region
+	state.client.GetRegion().SendGroundItems(state.client)
+	state.client.GetRegion().SendHazardZones(state.client)
+	state.client.GetRegion().SendNPCs(state.client)
+
+	// Tell our friends we changed region
+	hub.NotifyFriends(state.client, true)
 }
 
 // Sent by the client to go back to the login state
@@ -375,16 +506,19 @@ func (state *Game) HandleLogoutRequest() {
 	character := state.client.GetPlayerCharacter()
 	if character != nil {
 		// Server logging
-		state.logger.Printf("%s has logged out", character.Name)
+		state.logger.Info("Logged out", logging.RegionId, character.GetRegionId())
 
 		// Store this player's character data on logout
 		err := state.client.GetHub().SaveCharacter(state.client)
 		if err != nil {
-			state.logger.Printf("Failed to save character to database: %v", err)
+			state.logger.Error("Failed to save character to database", "error", err)
 		}
 
 		// Broadcast to everyone that this client left before we remove it from the hub/region
 		state.client.Broadcast(packets.NewClientLeft(character.Name))
+
+		// Tell our friends we went offline
+		state.client.GetHub().NotifyFriends(state.client, false)
 	}
 
 	// If we are connected to a region, remove the client from this region
@@ -409,6 +543,17 @@ func (state *Game) HandleLogoutRequest() {
 }
 
 // Broadcast to everybody we either opened/closed our chat input
+// Muted players can't chat, we tell them why instead
+func (state *Game) isMuted() bool {
+	now := time.Now()
+	mute := state.player.GetMute()
+	if !mute.IsActive(now) {
+		return false
+	}
+	state.client.SendPacket(packets.NewRequestDenied(mute.Describe(now)))
+	return true
+}
+
 func (state *Game) HandleChatBubble(payload *packets.ChatBubble) {
 	state.client.Broadcast(packets.NewChatBubble(payload.GetIsActive()))
 }
@@ -482,17 +627,28 @@ func (state *Game) HandleRotateCharacter(payload *packets.RotateCharacter) {
 }
 
 func (state *Game) HandleFireWeapon(payload *packets.FireWeapon) {
+	state.client.GetRegion().EndSpawnProtection(state.client)
+	state.recordShot()
 	state.player.FireCurrentWeapon() // This decreases our ammo in the server
 	// Get the hit position from the packet and broadcast to everyone in the region
 	state.client.Broadcast(packets.NewFireWeapon(payload.GetHit()))
 }
 
 func (state *Game) HandleFireWeaponMultiple(payload *packets.FireWeaponMultiple) {
+	state.client.GetRegion().EndSpawnProtection(state.client)
+	state.recordShot()
 	state.player.FireCurrentWeapon() // This decreases our ammo in the server
 	// Get the hits from the packet and broadcast to everyone in the region
 	state.client.Broadcast(packets.NewFireWeaponMultiple(payload.GetHits()))
 }
 
+// Counts a shot of the current weapon in our combat stats
+func (state *Game) recordShot() {
+	if weapon := state.player.GetCurrentWeaponSlot(); weapon != nil {
+		state.player.GetUnsavedStats().RecordShot(weapon.WeaponName)
+	}
+}
+
 func (state *Game) HandleToggleFireMode(payload *packets.ToggleFireMode) {
 	// Overwrite this character's fire mode
 	state.player.ToggleCurrentWeaponFireMode()
@@ -501,31 +657,48 @@ func (state *Game) HandleToggleFireMode(payload *packets.ToggleFireMode) {
 }
 
 func (state *Game) HandleReportPlayerDamage(payload *packets.ReportPlayerDamage) {
-	// Validate the target exists
+	region := state.client.GetRegion()
+
+	// Validate the target exists, NPC IDs start above NPC_ID_OFFSET so we know which map to look in
 	targetId := payload.GetTargetId()
-	targetClient, exists := state.client.GetRegion().Clients.Get(targetId)
-	if !exists {
-		state.logger.Printf("Invalid target ID %d in damage report packet", targetId)
+	isNpc := objects.IsNpcId(targetId)
+	var targetClient server.Client
+	var targetNpc *objects.NPC
+	var found bool
+	if isNpc {
+		targetNpc, found = region.NPCs.Get(targetId)
+	} else {
+		targetClient, found = region.Clients.Get(targetId)
+	}
+	if !found {
+		state.logger.Warn("Invalid target ID in damage report packet", logging.RegionId, region.Id, "target_id", targetId)
 		return
 	}
 
-	targetPlayer := targetClient.GetPlayerCharacter()
 	// If the target is already dead, ignore this damage
-	if !targetPlayer.IsAlive() {
+	if isNpc && !targetNpc.IsAlive() {
+		return
+	}
+	if !isNpc && !targetClient.GetPlayerCharacter().IsAlive() {
+		return
+	}
+
+	// Stunned characters can't shoot
+	if state.player.IsStunned() {
 		return
 	}
 
 	// Get attacker's weapon information
 	attackerWeapon := state.player.GetCurrentWeaponSlot()
 	if attackerWeapon == nil {
-		state.logger.Printf("Attacker has no weapon equipped")
+		state.logger.Warn("Attacker has no weapon equipped")
 		return
 	}
 
 	// Get weapon stats for anti-cheat validation
 	weaponStats, exists := objects.GetWeaponStats(attackerWeapon.WeaponName)
 	if !exists {
-		state.logger.Printf("Weapon %s not found in weapon data", attackerWeapon.WeaponName)
+		state.logger.Warn("Weapon not found in weapon data", "weapon", attackerWeapon.WeaponName)
 		return
 	}
 
@@ -534,15 +707,15 @@ func (state *Game) HandleReportPlayerDamage(payload *packets.ReportPlayerDamage)
 
 	// ANTI-CHEAT: Validate number of projectiles
 	if weaponStats.Projectiles > 0 && len(hits) > weaponStats.Projectiles {
-		state.logger.Printf("CHEAT DETECTED: Player %d reported %d hits, but weapon %s can only fire %d projectiles per shot. Packet ignored.",
-			state.client.GetId(), len(hits), attackerWeapon.WeaponName, weaponStats.Projectiles)
+		state.logger.Warn("CHEAT DETECTED: more hits than the weapon fires projectiles per shot, packet ignored",
+			logging.PacketType, "ReportPlayerDamage", "hits", len(hits), "weapon", attackerWeapon.WeaponName, "projectiles", weaponStats.Projectiles)
 		return // Ignore the packet entirely
 	}
 
 	// Additional validation: If weapon fires single projectile, it should have exactly 1 hit
 	if weaponStats.Projectiles == 1 && len(hits) != 1 {
-		state.logger.Printf("CHEAT DETECTED: Player %d reported %d hits for single-projectile weapon %s. Packet ignored.",
-			state.client.GetId(), len(hits), attackerWeapon.WeaponName)
+		state.logger.Warn("CHEAT DETECTED: wrong hit count for single-projectile weapon, packet ignored",
+			logging.PacketType, "ReportPlayerDamage", "hits", len(hits), "weapon", attackerWeapon.WeaponName)
 		return
 	}
 
@@ -551,59 +724,19 @@ func (state *Game) HandleReportPlayerDamage(payload *packets.ReportPlayerDamage)
 		return
 	}
 
-	// Calculate total damage from all hits
-	var totalDamage uint64 = 0
-	var anyCritical bool = false
-
-	// Calculate damage for each hit
-	for _, hit := range hits {
-		var damage uint64 = getWeaponDamage(attackerWeapon.WeaponName)
-		// Apply critical multipler if this hit was critical
-		if hit.GetIsCritical() {
-			damage = damage * 2
-			anyCritical = true
-		}
-
-		totalDamage += damage
+	// The region rolls the damage, we only tell it which hits were critical
+	criticalHits := make([]bool, len(hits))
+	for i, hit := range hits {
+		criticalHits[i] = hit.GetIsCritical()
 	}
 
-	// Apply total damage to target
-	targetPlayer.DecreaseHealth(totalDamage)
-
-	// Send the damage packet after applying damage
-	applyDamagePacket := packets.NewApplyPlayerDamage(
-		state.client.GetId(),
-		targetId,
-		totalDamage,
-		"bullet", // TO FIX -> CAUTION, EACH WEAPON SHOULD HAVE DAMAGE TOO
-		anyCritical,
-	)
-
-	// Tell everyone to apply the damage (including the attacker)
-	state.client.SendPacket(applyDamagePacket)
-	state.client.Broadcast(applyDamagePacket)
-
-	// If the player died
-	if !targetPlayer.IsAlive() {
-		// Get the grid where the target died
-		grid := targetClient.GetRegion().GetGrid()
-
-		// Remove target player from server grid immediately (both, current position and destination)
-		grid.SetObject(targetPlayer.GetGridPosition(), nil)
-		grid.SetObject(targetPlayer.GetGridDestination(), nil)
-
-		// Create death notification packet
-		playerDiedPacket := packets.NewPlayerDied(
-			state.client.GetId(),
-			targetId,
-		)
-
-		// Tell everyone to this player died
-		state.client.SendPacket(playerDiedPacket)
-		state.client.Broadcast(playerDiedPacket)
-
-		// The player stays dead until they send a RespawnRequest packet
+	if isNpc {
+		region.DamageNPC(state.client, targetNpc, attackerWeapon.WeaponName, criticalHits)
+		return
 	}
+
+	// If the player dies, only we can loot what they dropped for a while
+	region.DamagePlayer(targetClient, state.client.GetId(), state.client.GetCharacterId(), state.player.GetGridPosition(), attackerWeapon.WeaponName, criticalHits)
 }
 
 // Processes client respawn after death
@@ -611,7 +744,7 @@ func (state *Game) HandleRespawnRequest(payload *packets.RespawnRequest) {
 	player := state.client.GetPlayerCharacter()
 
 	if player.IsAlive() {
-		state.logger.Printf("Player %s tried to respawn but is still alive", player.Name)
+		state.logger.Info("Tried to respawn but is still alive")
 		return
 	}
 
@@ -631,14 +764,22 @@ func (state *Game) HandleRespawnRequest(payload *packets.RespawnRequest) {
 	// Respawn the player
 	err := state.client.GetRegion().RespawnPlayer(state.client, desiredPosition)
 	if err != nil {
-		state.logger.Printf("Failed to respawn player %s: %v", player.Name, err)
+		state.logger.Warn("Failed to respawn", logging.RegionId, regionId, "error", err)
 		// Send a request denied packet to the client
 		state.client.SendPacket(packets.NewRequestDenied("Respawn failed: " + err.Error()))
 	} else {
-		state.logger.Printf("Player %s respawned at region id: %d at position: (%d, %d)", player.Name, regionId, desiredPosition.X, desiredPosition.Z)
+		state.logger.Info("Respawned", logging.RegionId, regionId, "x", desiredPosition.X, "z", desiredPosition.Z)
 	}
 }
 
+// Sends the respawners again with their current safety, only while we are dead
+func (state *Game) HandleRespawnOptionsRequest() {
+	if state.player.IsAlive() {
+		return
+	}
+	state.client.GetRegion().SendRespawnOptions(state.client)
+}
+
 // Handles character crouch state change
 func (state *Game) HandleCrouchCharacter(payload *packets.CrouchCharacter) {
 	isCrouching := payload.GetIsCrouching()
@@ -649,3 +790,374 @@ func (state *Game) HandleCrouchCharacter(payload *packets.CrouchCharacter) {
 	// Broadcast the crouch state to everyone else in the region
 	state.client.Broadcast(packets.NewCrouchCharacter(isCrouching))
 }
+
+// Sends a friend request to the character with that nickname
+func (state *Game) HandleFriendRequest(payload *packets.FriendRequest) {
+	err := state.client.GetHub().SendFriendRequest(state.client, payload.GetNickname())
+	if err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Friend request failed: " + err.Error()))
+		return
+	}
+	state.client.SendPacket(packets.NewRequestGranted())
+}
+
+// Accepts a pending friend request from the character with that nickname
+func (state *Game) HandleFriendAccept(payload *packets.FriendAccept) {
+	err := state.client.GetHub().AcceptFriendRequest(state.client, payload.GetNickname())
+	if err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Friend accept failed: " + err.Error()))
+	}
+}
+
+// Removes a friend, or declines/cancels a pending request with that nickname
+func (state *Game) HandleFriendRemove(payload *packets.FriendRemove) {
+	err := state.client.GetHub().RemoveFriend(state.client, payload.GetNickname())
+	if err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Friend remove failed: " + err.Error()))
+	}
+}
+
+// Creates a new guild with our character as the leader
+func (state *Game) HandleGuildCreate(payload *packets.GuildCreate) {
+	name := payload.GetName()
+	if err := validateGuildName(name); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied(fmt.Sprintf("Invalid guild name: %v", err)))
+		return
+	}
+
+	// Tags are always displayed in uppercase
+	tag := strings.ToUpper(payload.GetTag())
+	if err := validateGuildTag(tag); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied(fmt.Sprintf("Invalid guild tag: %v", err)))
+		return
+	}
+
+	if err := state.client.GetHub().CreateGuild(state.client, name, tag); err != nil {
+		state.logger.Warn("Failed to create guild", "guild", name, "error", err)
+		state.client.SendPacket(packets.NewRequestDenied("Guild creation failed: " + err.Error()))
+		return
+	}
+
+	state.logger.Info("Created guild", "guild", name, "tag", tag)
+	state.client.SendPacket(packets.NewRequestGranted())
+}
+
+// Disbands our guild
+func (state *Game) HandleGuildDisband() {
+	if err := state.client.GetHub().DisbandGuild(state.client); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Guild disband failed: " + err.Error()))
+	}
+}
+
+// Invites another character to our guild
+func (state *Game) HandleGuildInvite(payload *packets.GuildInvite) {
+	if err := state.client.GetHub().InviteToGuild(state.client, payload.GetNickname()); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Guild invite failed: " + err.Error()))
+	}
+}
+
+// Accepts or declines the last guild invite we received
+func (state *Game) HandleGuildInviteResponse(payload *packets.GuildInviteResponse) {
+	if err := state.client.GetHub().RespondGuildInvite(state.client, payload.GetAccept()); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Guild invite failed: " + err.Error()))
+	}
+}
+
+// Leaves our guild
+func (state *Game) HandleGuildLeave() {
+	if err := state.client.GetHub().LeaveGuild(state.client); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Guild leave failed: " + err.Error()))
+	}
+}
+
+// Kicks a member with a lower rank from our guild
+func (state *Game) HandleGuildKick(payload *packets.GuildKick) {
+	if err := state.client.GetHub().KickFromGuild(state.client, payload.GetNickname()); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Guild kick failed: " + err.Error()))
+	}
+}
+
+// Promotes or demotes a member of our guild
+func (state *Game) HandleGuildSetRank(payload *packets.GuildSetRank) {
+	if err := state.client.GetHub().SetGuildRank(state.client, payload.GetNickname(), payload.GetRank()); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Guild rank change failed: " + err.Error()))
+	}
+}
+
+// Renames a rank of our guild or changes its permissions
+func (state *Game) HandleGuildEditRank(payload *packets.GuildEditRank) {
+	name := payload.GetName()
+	if err := validateGuildRankName(name); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied(fmt.Sprintf("Invalid rank name: %v", err)))
+		return
+	}
+
+	if err := state.client.GetHub().EditGuildRank(state.client, payload.GetRank(), name, payload.GetPermissions()); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Guild rank edit failed: " + err.Error()))
+	}
+}
+
+// Sends a message to every online member of our guild
+func (state *Game) HandleGuildMessage(payload *packets.GuildMessage) {
+	if state.isMuted() {
+		return
+	}
+	// The server ignores the nickname from the packet and uses the one from memory
+	if err := state.client.GetHub().SendGuildMessage(state.client, payload.GetText()); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Guild message failed: " + err.Error()))
+	}
+}
+
+// Moves, splits, merges or swaps stacks inside our inventory
+func (state *Game) HandleInventoryMove(payload *packets.InventoryMove) {
+	inventory := state.player.GetInventory()
+	err := inventory.MoveItem(payload.GetFromSlot(), payload.GetToSlot(), payload.GetQuantity())
+	if err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Inventory move failed: " + err.Error()))
+	}
+	// Always resync so the client can undo its prediction
+	state.client.SendPacket(packets.NewInventorySnapshot(inventory))
+}
+
+// Starts using one unit of the item in that slot
+func (state *Game) HandleInventoryUse(payload *packets.InventoryUse) {
+	if err := state.client.GetRegion().StartItemUse(state.client, payload.GetSlot()); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Inventory use failed: " + err.Error()))
+	}
+}
+
+// Throws items out of our inventory
+func (state *Game) HandleInventoryDrop(payload *packets.InventoryDrop) {
+	inventory := state.player.GetInventory()
+	item, err := inventory.RemoveFromSlot(payload.GetSlot(), payload.GetQuantity())
+	if err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Inventory drop failed: " + err.Error()))
+		return
+	}
+
+	// Anyone can pick up items we drop ourselves
+	state.client.GetRegion().DropItem(item, state.player.GetGridPosition(), 0)
+	state.client.SendPacket(packets.NewInventorySnapshot(inventory))
+}
+
+// Picks up an item lying on the ground near our character
+func (state *Game) HandlePickupGroundItem(payload *packets.PickupGroundItem) {
+	if err := state.client.GetRegion().PickupGroundItem(state.client, payload.GetId()); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Pickup failed: " + err.Error()))
+	}
+}
+
+// Moves a weapon item into a weapon slot, the weapon that was there goes back into the inventory
+func (state *Game) HandleInventoryEquip(payload *packets.InventoryEquip) {
+	inventory := state.player.GetInventory()
+	slot := payload.GetSlot()
+	weaponSlot := payload.GetWeaponSlot()
+
+	if weaponSlot >= objects.MAX_WEAPON_SLOTS {
+		state.client.SendPacket(packets.NewRequestDenied("Equip failed: invalid weapon slot"))
+		return
+	}
+
+	item := inventory.GetSlot(slot)
+	if item == nil {
+		state.client.SendPacket(packets.NewRequestDenied("Equip failed: slot is empty"))
+		return
+	}
+	stats, exists := objects.GetItemStats(item.ItemName)
+	if !exists || stats.Type != objects.ITEM_TYPE_WEAPON {
+		state.client.SendPacket(packets.NewRequestDenied("Equip failed: item is not a weapon"))
+		return
+	}
+	weaponStats, exists := objects.GetWeaponStats(item.ItemName)
+	if !exists {
+		state.client.SendPacket(packets.NewRequestDenied("Equip failed: unknown weapon"))
+		return
+	}
+
+	// Take the new weapon out first so its slot and weight are free for the old one
+	removed, _ := inventory.RemoveFromSlot(slot, 1)
+	if err := state.storeWeapon(weaponSlot); err != nil {
+		// Put the new weapon back where it was
+		if inventory.GetSlot(slot) == nil {
+			inventory.SetSlot(slot, removed)
+		} else {
+			inventory.GetSlot(slot).Quantity++
+		}
+		state.client.SendPacket(packets.NewRequestDenied("Equip failed: " + err.Error()))
+		return
+	}
+
+	// The bullets the weapon was stored with are loaded like a reload would, the rest goes to the reserve
+	// It can go over the reserve capacity by a chambered bullet, it came out of this same weapon anyway
+	loaded := min(removed.Ammo, weaponStats.MagazineCapacity)
+	state.player.SetWeaponSlot(weaponSlot, removed.ItemName, stats.WeaponType, stats.DisplayName, loaded > 0, loaded, removed.Ammo-loaded, 0)
+
+	updateWeaponPacket := packets.NewUpdateWeaponSlot(weaponSlot, state.player.GetWeaponSlot(weaponSlot))
+	state.client.SendPacket(updateWeaponPacket)
+	state.client.Broadcast(updateWeaponPacket)
+	state.client.SendPacket(packets.NewInventorySnapshot(inventory))
+}
+
+// Moves the weapon in a weapon slot back into the inventory
+func (state *Game) HandleInventoryUnequip(payload *packets.InventoryUnequip) {
+	weaponSlot := payload.GetWeaponSlot()
+	if weaponSlot >= objects.MAX_WEAPON_SLOTS {
+		state.client.SendPacket(packets.NewRequestDenied("Unequip failed: invalid weapon slot"))
+		return
+	}
+
+	weapon := state.player.GetWeaponSlot(weaponSlot)
+	if weapon == nil || weapon.WeaponName == "unarmed" {
+		state.client.SendPacket(packets.NewRequestDenied("Unequip failed: weapon slot is empty"))
+		return
+	}
+
+	if err := state.storeWeapon(weaponSlot); err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Unequip failed: " + err.Error()))
+		return
+	}
+	state.player.SetWeaponSlot(weaponSlot, "unarmed", "unarmed", "Empty", false, 0, 0, 0)
+
+	updateWeaponPacket := packets.NewUpdateWeaponSlot(weaponSlot, state.player.GetWeaponSlot(weaponSlot))
+	state.client.SendPacket(updateWeaponPacket)
+	state.client.Broadcast(updateWeaponPacket)
+	state.client.SendPacket(packets.NewInventorySnapshot(state.player.GetInventory()))
+}
+
+// Wears the armor piece in that inventory slot, the piece we were wearing goes back into the inventory
+func (state *Game) HandleArmorEquip(payload *packets.ArmorEquip) {
+	inventory := state.player.GetInventory()
+	slot := payload.GetSlot()
+
+	item := inventory.GetSlot(slot)
+	if item == nil {
+		state.client.SendPacket(packets.NewRequestDenied("Armor equip failed: slot is empty"))
+		return
+	}
+	stats, exists := objects.GetItemStats(item.ItemName)
+	if !exists || stats.Type != objects.ITEM_TYPE_ARMOR {
+		state.client.SendPacket(packets.NewRequestDenied("Armor equip failed: item is not armor"))
+		return
+	}
+
+	// Take the new piece out first so its slot and weight are free for the old one
+	removed, _ := inventory.RemoveFromSlot(slot, 0)
+	if previous := state.player.GetArmor(stats.ArmorSlot); previous != nil {
+		if !inventory.AddWornItem(previous.ItemName, previous.Wear) {
+			inventory.SetSlot(slot, removed)
+			state.client.SendPacket(packets.NewRequestDenied("Armor equip failed: no room in inventory for the armor we are wearing"))
+			return
+		}
+	}
+	state.player.SetArmor(stats.ArmorSlot, removed)
+
+	state.client.SendPacket(packets.NewArmorSnapshot(state.player))
+	state.client.SendPacket(packets.NewInventorySnapshot(inventory))
+}
+
+// Moves the armor piece in that armor slot back into the inventory
+func (state *Game) HandleArmorUnequip(payload *packets.ArmorUnequip) {
+	armorSlot := payload.GetArmorSlot()
+	piece := state.player.GetArmor(armorSlot)
+	if piece == nil {
+		state.client.SendPacket(packets.NewRequestDenied("Armor unequip failed: armor slot is empty"))
+		return
+	}
+
+	inventory := state.player.GetInventory()
+	if !inventory.AddWornItem(piece.ItemName, piece.Wear) {
+		state.client.SendPacket(packets.NewRequestDenied("Armor unequip failed: no room in inventory"))
+		return
+	}
+	state.player.SetArmor(armorSlot, nil)
+
+	state.client.SendPacket(packets.NewArmorSnapshot(state.player))
+	state.client.SendPacket(packets.NewInventorySnapshot(inventory))
+}
+
+// Puts the weapon in this weapon slot into the inventory, its bullets are packed into full ammo boxes
+// Bullets that don't make a full box, or boxes that don't fit, stay in the weapon item so nothing is lost
+// Does nothing if the slot is unarmed
+func (state *Game) storeWeapon(weaponSlot uint64) error {
+	weapon := state.player.GetWeaponSlot(weaponSlot)
+	if weapon == nil || weapon.WeaponName == "unarmed" {
+		return nil
+	}
+
+	inventory := state.player.GetInventory()
+	item := inventory.AddStoredWeapon(weapon.WeaponName, weapon.Ammo+weapon.ReserveAmmo)
+	if item == nil {
+		return fmt.Errorf("no room in inventory for %s", weapon.DisplayName)
+	}
+
+	if ammoItemName, exists := objects.GetAmmoItemName(weapon.WeaponType); exists {
+		ammoStats, _ := objects.GetItemStats(ammoItemName)
+		boxes := item.Ammo / ammoStats.AmmoAmount
+		if boxes > 0 {
+			leftover := inventory.AddItem(ammoItemName, boxes)
+			item.Ammo -= (boxes - leftover) * ammoStats.AmmoAmount
+		}
+	}
+
+	return nil
+}
+
+// Sends the combat record of a character, our own if no nickname is given
+func (state *Game) HandleStatsRequest(payload *packets.StatsRequest) {
+	err := state.client.GetHub().SendCombatStats(state.client, payload.GetNickname())
+	if err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Stats request failed: " + err.Error()))
+	}
+}
+
+// Sends a page of a leaderboard
+func (state *Game) HandleLeaderboardRequest(payload *packets.LeaderboardRequest) {
+	err := state.client.GetHub().SendLeaderboardPage(state.client, payload.GetCategory(), payload.GetPage())
+	if err != nil {
+		state.client.SendPacket(packets.NewRequestDenied("Leaderboard request failed: " + err.Error()))
+	}
+}
+
+// Validate guild name before creating the guild
+func validateGuildName(name string) error {
+	if len(name) < 3 {
+		return errors.New("name is too short")
+	}
+	if len(name) > 24 {
+		return errors.New("name is too long")
+	}
+	if name != strings.TrimSpace(name) {
+		return errors.New("name can't start or end with spaces")
+	}
+	return nil
+}
+
+// Validate guild tag before creating the guild
+func validateGuildTag(tag string) error {
+	if len(tag) < 2 {
+		return errors.New("tag is too short")
+	}
+	if len(tag) > 4 {
+		return errors.New("tag is too long")
+	}
+	for _, character := range tag {
+		if (character < 'A' || character > 'Z') && (character < '0' || character > '9') {
+			return errors.New("tag can only have letters and numbers")
+		}
+	}
+	return nil
+}
+
+// Validate rank name before renaming a guild rank
+func validateGuildRankName(name string) error {
+	if len(name) <= 0 {
+		return errors.New("name can't be empty")
+	}
+	if len(name) > 16 {
+		return errors.New("name is too long")
+	}
+	if name != strings.TrimSpace(name) {
+		return errors.New("name can't start or end with spaces")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// Adds the combat stats recorded since the last save to the totals in the database
+func saveCombatStats(ctx context.Context, q *db.Queries, characterId int64, stats *objects.CombatStats) error {
+	if stats.IsEmpty() {
+		return nil
+	}
+
+	err := q.AddCharacterStats(ctx, db.AddCharacterStatsParams{
+		CharacterID: characterId,
+		Kills:       int64(stats.Kills),
+		NpcKills:    int64(stats.NpcKills),
+		Deaths:      int64(stats.Deaths),
+		DamageDealt: int64(stats.DamageDealt),
+		DamageTaken: int64(stats.DamageTaken),
+		ShotsFired:  int64(stats.ShotsFired),
+		Hits:        int64(stats.Hits),
+		Headshots:   int64(stats.Headshots),
+	})
+	if err != nil {
+		return fmt.Errorf("add character stats: %w", err)
+	}
+
+	for weaponName, usage := range stats.Weapons {
+		err := q.AddCharacterWeaponStats(ctx, db.AddCharacterWeaponStatsParams{
+			CharacterID: characterId,
+			WeaponName:  weaponName,
+			Kills:       int64(usage.Kills),
+			DamageDealt: int64(usage.DamageDealt),
+			ShotsFired:  int64(usage.ShotsFired),
+			Hits:        int64(usage.Hits),
+			Headshots:   int64(usage.Headshots),
+		})
+		if err != nil {
+			return fmt.Errorf("add weapon stats: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Returns the saved combat record of a character (empty if they never fought)
+func (h *Hub) LoadCombatStats(characterId int64) (*objects.CombatStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	stats := objects.CreateCombatStats()
+
+	row, err := h.queries.GetCharacterStats(ctx, characterId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return stats, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get character stats: %w", err)
+	}
+	stats.Kills = uint64(row.Kills)
+	stats.NpcKills = uint64(row.NpcKills)
+	stats.Deaths = uint64(row.Deaths)
+	stats.DamageDealt = uint64(row.DamageDealt)
+	stats.DamageTaken = uint64(row.DamageTaken)
+	stats.ShotsFired = uint64(row.ShotsFired)
+	stats.Hits = uint64(row.Hits)
+	stats.Headshots = uint64(row.Headshots)
+
+	weapons, err := h.queries.GetCharacterWeaponStats(ctx, characterId)
+	if err != nil {
+		return nil, fmt.Errorf("get weapon stats: %w", err)
+	}
+	for _, weapon := range weapons {
+		stats.Weapons[weapon.WeaponName] = &objects.WeaponUsage{
+			Kills:       uint64(weapon.Kills),
+			DamageDealt: uint64(weapon.DamageDealt),
+			ShotsFired:  uint64(weapon.ShotsFired),
+			Hits:        uint64(weapon.Hits),
+			Headshots:   uint64(weapon.Headshots),
+		}
+	}
+
+	return stats, nil
+}
+
+// Sends the combat record of the character with that nickname to this client (our own if empty)
+func (h *Hub) SendCombatStats(client Client, nickname string) error {
+	var characterId int64
+	if nickname == "" {
+		characterId = client.GetCharacterId()
+		nickname = client.GetPlayerCharacter().Name
+	} else {
+		target, err := h.GetUserByNickname(nickname)
+		if err != nil || !target.CharacterID.Valid {
+			return errors.New("character not found")
+		}
+		characterId = target.CharacterID.Int64
+		nickname = target.Nickname
+	}
+
+	stats, err := h.LoadCombatStats(characterId)
+	if err != nil {
+		return err
+	}
+
+	// Online characters also have what they did since they were last saved
+	if target, online := h.GetClientByNickname(nickname); online && target.GetPlayerCharacter() != nil {
+		stats.Add(target.GetPlayerCharacter().GetUnsavedStats())
+	}
+
+	client.SendPacket(packets.NewStatsResponse(nickname, stats))
+	return nil
+}
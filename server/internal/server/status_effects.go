@@ -0,0 +1,102 @@
+package server
+
+import (
+	"math/rand"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// Applies a status effect to a client's character and tells everyone in the region
+// Returns false if the effect didn't change (unknown, dead target or not stackable)
+func (r *Region) ApplyStatusEffect(client Client, name string) bool {
+	player := client.GetPlayerCharacter()
+	if !player.IsAlive() {
+		return false
+	}
+
+	effect, changed := player.ApplyStatusEffect(name, time.Now())
+	if !changed {
+		return false
+	}
+
+	// Stunned characters stop where they are
+	if player.IsStunned() {
+		player.SetGridDestination(player.GetGridPosition())
+	}
+
+	statusEffectAppliedPacket := packets.NewStatusEffectApplied(client.GetId(), player, effect)
+	client.SendPacket(statusEffectAppliedPacket)
+	client.Broadcast(statusEffectAppliedPacket)
+	return true
+}
+
+// Removes a status effect before it expires (cures), does nothing if it isn't active
+func (r *Region) RemoveStatusEffect(client Client, name string) {
+	player := client.GetPlayerCharacter()
+	if !player.RemoveStatusEffect(name) {
+		return
+	}
+
+	statusEffectExpiredPacket := packets.NewStatusEffectExpired(client.GetId(), player, name)
+	client.SendPacket(statusEffectExpiredPacket)
+	client.Broadcast(statusEffectExpiredPacket)
+}
+
+// Applies the periodic damage and healing of every active effect, called every tick by the hub
+func (r *Region) updateStatusEffects(now time.Time) {
+	r.Clients.ForEach(func(id uint64, client Client) {
+		player := client.GetPlayerCharacter()
+		if player == nil || !player.IsAlive() {
+			return
+		}
+
+		ticks, expired := player.TickStatusEffects(now)
+
+		for _, tick := range ticks {
+			if tick.Heal > 0 {
+				healthBefore := player.GetHealth()
+				player.IncreaseHealth(tick.Heal)
+				if player.GetHealth() > healthBefore {
+					healPlayerPacket := packets.NewHealPlayer(id, player.GetHealth()-healthBefore, player.GetHealth(), tick.Name)
+					client.SendPacket(healPlayerPacket)
+					client.Broadcast(healPlayerPacket)
+				}
+			}
+
+			if tick.Damage > 0 {
+				// Damage over time ignores armor and doesn't interrupt items (medkits cure bleeding)
+				player.DecreaseHealth(tick.Damage)
+				player.GetUnsavedStats().DamageTaken += tick.Damage
+
+				// Attacker 0 means the damage came from the environment
+				applyDamagePacket := packets.NewApplyPlayerDamage(0, id, tick.Damage, tick.DamageType, false, 0, nil)
+				client.SendPacket(applyDamagePacket)
+				client.Broadcast(applyDamagePacket)
+
+				if !player.IsAlive() {
+					r.KillPlayer(client, 0, 0, tick.Name, false)
+					return
+				}
+			}
+		}
+
+		for _, name := range expired {
+			statusEffectExpiredPacket := packets.NewStatusEffectExpired(id, player, name)
+			client.SendPacket(statusEffectExpiredPacket)
+			client.Broadcast(statusEffectExpiredPacket)
+		}
+	})
+}
+
+// Rolls the on-hit status effect of a weapon for each hit that landed
+func (r *Region) ApplyWeaponHitEffects(target Client, weaponStats objects.WeaponStats, hits int) {
+	if weaponStats.HitEffect == "" {
+		return
+	}
+	for range hits {
+		if rand.Float64() < weaponStats.HitEffectChance {
+			r.ApplyStatusEffect(target, weaponStats.HitEffect)
+		}
+	}
+}
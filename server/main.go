@@ -5,78 +5,242 @@ import (
 	"database/sql"
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
 	"server/internal/server"
 	"server/internal/server/clients"
+	"server/internal/server/config"
+	"server/internal/server/db"
 	"server/internal/server/info"
+	"server/internal/server/logging"
 
-	_ "embed" // allows the use of the go:embed directive
+	"syscall"
 
 	"github.com/kardianos/osext"
 	_ "modernc.org/sqlite" // registers itself with the sql package
 )
 
-// Embed the database schema to be used when creating the database tables
-// the go:embed directive tells the compiler to include the contents of the
-// schema.sql file in the binary when it is built, so we can access it at runtime.
-
-//go:embed internal/server/db/config/schema.sql
-var schemaGenSql string
-
 // ADD A VERSION VARIABLE THAT SHOULD MATCH WITH THE CLIENT VARIABLE
 // The client should send this variable upon connection attempt and it
 // should match with the server's version to allow connection!
 
 var (
-	port = flag.Int("port", 31591, "Port to listen on")
+	// Settings file, everything in it can also be set with OUTSTAR_* environment variables
+	configPath = flag.String("config", os.Getenv("OUTSTAR_CONFIG"), "JSON config file, defaults to $OUTSTAR_CONFIG (built-in defaults if both are empty)")
+	dumpConfig = flag.Bool("dump-config", false, "Print the effective config as JSON and exit")
+	// The flags below override the config file and the environment when given
+	port = flag.Int("port", defaults.Port, "Port to listen on")
+	// Built-in TLS and websocket path, for small deployments without a reverse proxy
+	wsPath  = flag.String("ws-path", defaults.WsPath, "Path game clients connect to")
+	tlsCert = flag.String("tls-cert", "", "TLS certificate file, serves wss:// when given with -tls-key (reloaded when it changes)")
+	tlsKey  = flag.String("tls-key", "", "TLS private key file")
+	// Folder to record every client session to, replay them with cmd/replay
+	recordFolder = flag.String("record", "", "Record client sessions to this folder (disabled if empty)")
+	// Admin API, on its own listener so it can stay on localhost or a private network
+	adminAddr  = flag.String("admin-addr", defaults.AdminAddr, "Address the admin API listens on")
+	adminToken = flag.String("admin-token", "", "Bearer token for the admin API, defaults to $OUTSTAR_ADMIN_TOKEN (disabled if both are empty)")
+	// Metrics, also on their own listener, the game port is public
+	metricsAddr = flag.String("metrics-addr", defaults.MetricsAddr, "Address the Prometheus /metrics endpoint listens on (disabled if empty)")
+	// Logging, levels can be set per subsystem (hub, clients, states, regions, admin, recorder)
+	logFormat     = flag.String("log-format", defaults.Log.Format, "Log format, text or json")
+	logLevel      = flag.String("log-level", defaults.Log.Level, "Log level, optionally per subsystem, e.g. info,regions=debug,states=warn")
+	logFile       = flag.String("log-file", "", "Write logs to this file instead of stderr")
+	logMaxSize    = flag.Int64("log-max-size", defaults.Log.MaxSize, "Rotate the log file once it reaches this many megabytes (0 never rotates)")
+	logMaxBackups = flag.Int("log-max-backups", defaults.Log.MaxBackups, "Rotated log files to keep")
 )
 
+// Only used for the flag defaults shown by -help
+var defaults = config.Default()
+
 // Generic TCP server
 func main() {
 	// Reads the OS command-line flags
 	flag.Parse()
 
-	log.Printf("Starting server v %s", info.Version)
+	// Defaults, then the config file, then the environment, then the flags
+	settings, err := config.Load(*configPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "port":
+			settings.Port = *port
+		case "ws-path":
+			settings.WsPath = *wsPath
+		case "tls-cert":
+			settings.TLSCertFile = *tlsCert
+		case "tls-key":
+			settings.TLSKeyFile = *tlsKey
+		case "record":
+			settings.RecordFolder = *recordFolder
+		case "admin-addr":
+			settings.AdminAddr = *adminAddr
+		case "admin-token":
+			settings.AdminToken = *adminToken
+		case "metrics-addr":
+			settings.MetricsAddr = *metricsAddr
+		case "log-format":
+			settings.Log.Format = *logFormat
+		case "log-level":
+			settings.Log.Level = *logLevel
+		case "log-file":
+			settings.Log.File = *logFile
+		case "log-max-size":
+			settings.Log.MaxSize = *logMaxSize
+		case "log-max-backups":
+			settings.Log.MaxBackups = *logMaxBackups
+		}
+	})
+	if err := settings.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid config:\n%v\n", err)
+		os.Exit(2)
+	}
+
+	if *dumpConfig {
+		dumped, err := settings.Dump()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Stdout.Write(dumped)
+		return
+	}
+
+	// Logging goes first, every component creates its logger when it's built
+	logOutput, err := logging.Setup(settings.Logging())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	defer logOutput.Close()
+
+	slog.Info("Starting server", "version", info.Version)
 
 	// Get the absolute directory of the executable (Cross platform)
 	execPath, err := osext.ExecutableFolder()
 	if err != nil {
 		panic(err)
 	}
-	log.Printf("Server running from %s", execPath)
+	slog.Info("Server running", "path", execPath)
 
-	log.Printf("Connecting to the database at %s\\db.sqlite", execPath)
+	// We place the db next to the executable for easy of access, unless the config says otherwise
+	databasePath := settings.DatabasePath
+	if databasePath == "" {
+		databasePath = execPath + "/db.sqlite"
+	}
+	slog.Info("Connecting to the database", "path", databasePath)
 	// This generates the db.sqlite file if it doesn't exists
-	// We need to place the db next to the executable for easy of access
-	database, err := sql.Open("sqlite", execPath+"/db.sqlite?_busy_timeout=5000&_journal_mode=WAL&_sync=NORMAL")
+	database, err := sql.Open("sqlite", databasePath+"?_busy_timeout=5000&_journal_mode=WAL&_sync=NORMAL")
 	if err != nil {
 		panic(err)
 	}
-	// Query that doesn't return any rows to test the database connection
-	_, err = database.ExecContext(context.Background(), schemaGenSql)
+	// Creates the tables and brings databases from older versions up to date, also tests the connection
+	err = db.Migrate(context.Background(), database)
 	if err != nil {
 		panic(err)
 	}
 
+	// Game data files (weapons, respawners, chat filter) live next to the executable too
+	if settings.DataFolder == "" {
+		settings.DataFolder = execPath + "/data"
+	}
+
 	// Spawn the main hub that will take new websocket connections
-	hub := server.CreateHub(database)
+	hub := server.CreateHub(database, settings)
+	slog.Info("Hub settings", "server_tick", settings.ServerTick, "packets_per_client", settings.PacketsPerClient,
+		"send_buffer_size", settings.SendBufferSize, "processing_buffer_size", settings.ProcessingBufferSize)
+
+	// Optionally record every session to reproduce desyncs later
+	if settings.RecordFolder != "" {
+		err = hub.EnableRecording(settings.RecordFolder)
+		if err != nil {
+			panic(err)
+		}
+		slog.Info("Recording client sessions", "folder", settings.RecordFolder)
+	}
+
+	// Built-in TLS, the certificate is checked again on handshakes so renewals don't need a restart
+	var certificates *server.CertificateReloader
+	if settings.TLSCertFile != "" {
+		certificates, err = server.NewCertificateReloader(settings.TLSCertFile, settings.TLSKeyFile)
+		if err != nil {
+			slog.Error("Failed to load the TLS certificate", "error", err)
+			logOutput.Close()
+			os.Exit(1)
+		}
+	}
 
 	// Connect handler function that upgrades connection into a WebSocket connection
-	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc(settings.WsPath, func(w http.ResponseWriter, r *http.Request) {
 		hub.Serve(clients.NewWebSocketClient, w, r)
 	})
 
+	// Leaderboards as JSON for the community site
+	http.HandleFunc("/leaderboards", hub.ServeLeaderboards)
+
 	// Starts the main hub on a goroutine
 	go hub.Start()
 
-	addr := fmt.Sprintf(":%d", *port)
-	log.Printf("Server running on port %s", addr)
+	// kill -HUP reloads the game data without restarting (the admin API has POST /reload too)
+	reloadSignals := make(chan os.Signal, 1)
+	signal.Notify(reloadSignals, syscall.SIGHUP)
+	go func() {
+		for range reloadSignals {
+			report, err := hub.ReloadGameData()
+			if err != nil {
+				slog.Error("Game data reload failed, nothing was changed", "error", err)
+				continue
+			}
+			slog.Info("Game data reloaded", "files", report.Files, "weapons", report.Weapons,
+				"respawner_regions", report.RespawnerRegions, "chat_filter_words", report.ChatFilterWords)
+		}
+	}()
+
+	// Prometheus style metrics on their own listener, never on the public game port
+	if settings.MetricsAddr != "" {
+		go func() {
+			metricsMux := http.NewServeMux()
+			metricsMux.HandleFunc("GET /metrics", hub.ServeMetrics)
+			slog.Info("Metrics running", "addr", settings.MetricsAddr)
+			err := http.ListenAndServe(settings.MetricsAddr, metricsMux)
+			slog.Error("Metrics stopped", "error", err)
+		}()
+	}
+
+	// The admin API is only started when there is a token to protect it
+	if settings.AdminToken != "" {
+		go func() {
+			slog.Info("Admin API running", "addr", settings.AdminAddr)
+			err := http.ListenAndServe(settings.AdminAddr, hub.AdminHandler(settings.AdminToken))
+			slog.Error("Admin API stopped", "error", err)
+		}()
+	} else {
+		slog.Info("Admin API disabled, set -admin-token or OUTSTAR_ADMIN_TOKEN to enable it")
+	}
+
+	if len(settings.AllowedOrigins) == 0 {
+		slog.Warn("Any website can open a websocket to this server, set allowed_origins to restrict it")
+	}
+
+	addr := fmt.Sprintf(":%d", settings.Port)
 
 	// Starts the web server to listen for incoming TCP connections
-	err = http.ListenAndServe(addr, nil)
+	if certificates != nil {
+		slog.Info("Server running", "addr", addr, "ws_path", settings.WsPath, "tls", true)
+		httpServer := &http.Server{Addr: addr, TLSConfig: certificates.TLSConfig()}
+		err = httpServer.ListenAndServeTLS("", "") // Files come from TLSConfig.GetCertificate
+	} else {
+		slog.Info("Server running", "addr", addr, "ws_path", settings.WsPath, "tls", false)
+		err = http.ListenAndServe(addr, nil)
+	}
 
 	if err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+		slog.Error("Failed to start server", "error", err)
+		logOutput.Close()
+		os.Exit(1)
 	}
 }
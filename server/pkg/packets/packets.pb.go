@@ -827,6 +827,7 @@ type SpawnCharacter struct {
 	CurrentWeapon uint64        `protobuf:"varint,9,opt,name=current_weapon,json=currentWeapon,proto3" json:"current_weapon,omitempty"` // Current equipped weapon by slot
 	Weapons       []*WeaponSlot `protobuf:"bytes,10,rep,name=weapons,proto3" json:"weapons,omitempty"`                                  // All weapon slots
 	IsCrouching   bool          `protobuf:"varint,11,opt,name=is_crouching,json=isCrouching,proto3" json:"is_crouching,omitempty"`
+	GuildTag      string        `protobuf:"bytes,12,opt,name=guild_tag,json=guildTag,proto3" json:"guild_tag,omitempty"` // Empty if the character is not in a guild
 }
 
 func (x *SpawnCharacter) Reset() {
@@ -936,6 +937,13 @@ func (x *SpawnCharacter) GetIsCrouching() bool {
 	return false
 }
 
+func (x *SpawnCharacter) GetGuildTag() string {
+	if x != nil {
+		return x.GuildTag
+	}
+	return ""
+}
+
 // Character movement
 type MoveCharacter struct {
 	state         protoimpl.MessageState
@@ -1637,11 +1645,13 @@ type ApplyPlayerDamage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttackerId uint64 `protobuf:"varint,1,opt,name=attacker_id,json=attackerId,proto3" json:"attacker_id,omitempty"` // In case we want to draw a marker with the attacker's direction in the target's screen
-	TargetId   uint64 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`       // So everyone can reduce the health of this player on this screens
-	Damage     uint64 `protobuf:"varint,3,opt,name=damage,proto3" json:"damage,omitempty"`                           // The damage number to apply
-	DamageType string `protobuf:"bytes,4,opt,name=damage_type,json=damageType,proto3" json:"damage_type,omitempty"`  // If we want to draw different damage colors/effects
-	IsCritical bool   `protobuf:"varint,5,opt,name=is_critical,json=isCritical,proto3" json:"is_critical,omitempty"` // Critical damage (usually headshots)
+	AttackerId      uint64        `protobuf:"varint,1,opt,name=attacker_id,json=attackerId,proto3" json:"attacker_id,omitempty"`                // In case we want to draw a marker with the attacker's direction in the target's screen
+	TargetId        uint64        `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`                      // So everyone can reduce the health of this player on this screens
+	Damage          uint64        `protobuf:"varint,3,opt,name=damage,proto3" json:"damage,omitempty"`                                          // The damage number to apply
+	DamageType      string        `protobuf:"bytes,4,opt,name=damage_type,json=damageType,proto3" json:"damage_type,omitempty"`                 // If we want to draw different damage colors/effects
+	IsCritical      bool          `protobuf:"varint,5,opt,name=is_critical,json=isCritical,proto3" json:"is_critical,omitempty"`                // Critical damage (usually headshots)
+	MitigatedDamage uint64        `protobuf:"varint,6,opt,name=mitigated_damage,json=mitigatedDamage,proto3" json:"mitigated_damage,omitempty"` // Damage absorbed by armor
+	Armor           []*ArmorPiece `protobuf:"bytes,7,rep,name=armor,proto3" json:"armor,omitempty"`                                             // Armor pieces that were hit, with their durability after the hit
 }
 
 func (x *ApplyPlayerDamage) Reset() {
@@ -1709,6 +1719,20 @@ func (x *ApplyPlayerDamage) GetIsCritical() bool {
 	return false
 }
 
+func (x *ApplyPlayerDamage) GetMitigatedDamage() uint64 {
+	if x != nil {
+		return x.MitigatedDamage
+	}
+	return 0
+}
+
+func (x *ApplyPlayerDamage) GetArmor() []*ArmorPiece {
+	if x != nil {
+		return x.Armor
+	}
+	return nil
+}
+
 type PlayerDied struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1868,64 +1892,29 @@ func (x *CrouchCharacter) GetIsCrouching() bool {
 	return false
 }
 
-// Main Packet container
-type Packet struct {
+// Friends
+type FriendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SenderId uint64 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// Types that are assignable to Payload:
-	//
-	//	*Packet_PublicMessage
-	//	*Packet_Handshake
-	//	*Packet_Heartbeat
-	//	*Packet_ServerMetrics
-	//	*Packet_RequestGranted
-	//	*Packet_RequestDenied
-	//	*Packet_LoginRequest
-	//	*Packet_RegisterRequest
-	//	*Packet_LoginSuccess
-	//	*Packet_LogoutRequest
-	//	*Packet_ClientEntered
-	//	*Packet_ClientLeft
-	//	*Packet_JoinRegionRequest
-	//	*Packet_RegionData
-	//	*Packet_SpawnCharacter
-	//	*Packet_MoveCharacter
-	//	*Packet_RotateCharacter
-	//	*Packet_Destination
-	//	*Packet_UpdateSpeed
-	//	*Packet_ChatBubble
-	//	*Packet_SwitchWeapon
-	//	*Packet_ReloadWeapon
-	//	*Packet_RaiseWeapon
-	//	*Packet_LowerWeapon
-	//	*Packet_FireWeapon
-	//	*Packet_FireWeaponMultiple
-	//	*Packet_ToggleFireMode
-	//	*Packet_ReportPlayerDamage
-	//	*Packet_ApplyPlayerDamage
-	//	*Packet_PlayerDied
-	//	*Packet_RespawnRequest
-	//	*Packet_CrouchCharacter
-	Payload isPacket_Payload `protobuf_oneof:"payload"`
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
 }
 
-func (x *Packet) Reset() {
-	*x = Packet{}
+func (x *FriendRequest) Reset() {
+	*x = FriendRequest{}
 	mi := &file_packets_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) String() string {
+func (x *FriendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
+func (*FriendRequest) ProtoMessage() {}
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
+func (x *FriendRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_packets_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1937,245 +1926,4513 @@ func (x *Packet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
-func (*Packet) Descriptor() ([]byte, []int) {
+// Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
+func (*FriendRequest) Descriptor() ([]byte, []int) {
 	return file_packets_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Packet) GetSenderId() uint64 {
+func (x *FriendRequest) GetNickname() string {
 	if x != nil {
-		return x.SenderId
+		return x.Nickname
 	}
-	return 0
+	return ""
 }
 
-func (m *Packet) GetPayload() isPacket_Payload {
-	if m != nil {
-		return m.Payload
-	}
-	return nil
+type FriendAccept struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
 }
 
-func (x *Packet) GetPublicMessage() *PublicMessage {
-	if x, ok := x.GetPayload().(*Packet_PublicMessage); ok {
-		return x.PublicMessage
-	}
-	return nil
+func (x *FriendAccept) Reset() {
+	*x = FriendAccept{}
+	mi := &file_packets_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) GetHandshake() *Handshake {
-	if x, ok := x.GetPayload().(*Packet_Handshake); ok {
-		return x.Handshake
-	}
-	return nil
+func (x *FriendAccept) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Packet) GetHeartbeat() *Heartbeat {
-	if x, ok := x.GetPayload().(*Packet_Heartbeat); ok {
-		return x.Heartbeat
+func (*FriendAccept) ProtoMessage() {}
+
+func (x *FriendAccept) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetServerMetrics() *ServerMetrics {
-	if x, ok := x.GetPayload().(*Packet_ServerMetrics); ok {
-		return x.ServerMetrics
-	}
-	return nil
+// Deprecated: Use FriendAccept.ProtoReflect.Descriptor instead.
+func (*FriendAccept) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Packet) GetRequestGranted() *RequestGranted {
-	if x, ok := x.GetPayload().(*Packet_RequestGranted); ok {
-		return x.RequestGranted
+func (x *FriendAccept) GetNickname() string {
+	if x != nil {
+		return x.Nickname
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetRequestDenied() *RequestDenied {
-	if x, ok := x.GetPayload().(*Packet_RequestDenied); ok {
-		return x.RequestDenied
-	}
-	return nil
+type FriendRemove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
 }
 
-func (x *Packet) GetLoginRequest() *LoginRequest {
-	if x, ok := x.GetPayload().(*Packet_LoginRequest); ok {
-		return x.LoginRequest
-	}
-	return nil
+func (x *FriendRemove) Reset() {
+	*x = FriendRemove{}
+	mi := &file_packets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) GetRegisterRequest() *RegisterRequest {
-	if x, ok := x.GetPayload().(*Packet_RegisterRequest); ok {
-		return x.RegisterRequest
-	}
-	return nil
+func (x *FriendRemove) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Packet) GetLoginSuccess() *LoginSuccess {
-	if x, ok := x.GetPayload().(*Packet_LoginSuccess); ok {
-		return x.LoginSuccess
+func (*FriendRemove) ProtoMessage() {}
+
+func (x *FriendRemove) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetLogoutRequest() *LogoutRequest {
-	if x, ok := x.GetPayload().(*Packet_LogoutRequest); ok {
-		return x.LogoutRequest
-	}
-	return nil
+// Deprecated: Use FriendRemove.ProtoReflect.Descriptor instead.
+func (*FriendRemove) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Packet) GetClientEntered() *ClientEntered {
-	if x, ok := x.GetPayload().(*Packet_ClientEntered); ok {
-		return x.ClientEntered
+func (x *FriendRemove) GetNickname() string {
+	if x != nil {
+		return x.Nickname
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetClientLeft() *ClientLeft {
-	if x, ok := x.GetPayload().(*Packet_ClientLeft); ok {
-		return x.ClientLeft
-	}
-	return nil
+type FriendInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	IsOnline bool   `protobuf:"varint,2,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	RegionId uint64 `protobuf:"varint,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"` // Region the friend is at (0 if offline)
 }
 
-func (x *Packet) GetJoinRegionRequest() *JoinRegionRequest {
-	if x, ok := x.GetPayload().(*Packet_JoinRegionRequest); ok {
-		return x.JoinRegionRequest
-	}
-	return nil
+func (x *FriendInfo) Reset() {
+	*x = FriendInfo{}
+	mi := &file_packets_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) GetRegionData() *RegionData {
-	if x, ok := x.GetPayload().(*Packet_RegionData); ok {
-		return x.RegionData
-	}
-	return nil
+func (x *FriendInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Packet) GetSpawnCharacter() *SpawnCharacter {
-	if x, ok := x.GetPayload().(*Packet_SpawnCharacter); ok {
-		return x.SpawnCharacter
+func (*FriendInfo) ProtoMessage() {}
+
+func (x *FriendInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetMoveCharacter() *MoveCharacter {
-	if x, ok := x.GetPayload().(*Packet_MoveCharacter); ok {
-		return x.MoveCharacter
-	}
-	return nil
+// Deprecated: Use FriendInfo.ProtoReflect.Descriptor instead.
+func (*FriendInfo) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Packet) GetRotateCharacter() *RotateCharacter {
-	if x, ok := x.GetPayload().(*Packet_RotateCharacter); ok {
-		return x.RotateCharacter
+func (x *FriendInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetDestination() *Destination {
-	if x, ok := x.GetPayload().(*Packet_Destination); ok {
-		return x.Destination
+func (x *FriendInfo) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
 	}
-	return nil
+	return false
 }
 
-func (x *Packet) GetUpdateSpeed() *UpdateSpeed {
-	if x, ok := x.GetPayload().(*Packet_UpdateSpeed); ok {
-		return x.UpdateSpeed
+func (x *FriendInfo) GetRegionId() uint64 {
+	if x != nil {
+		return x.RegionId
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetChatBubble() *ChatBubble {
-	if x, ok := x.GetPayload().(*Packet_ChatBubble); ok {
-		return x.ChatBubble
+type FriendsList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friends         []*FriendInfo `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	PendingRequests []string      `protobuf:"bytes,2,rep,name=pending_requests,json=pendingRequests,proto3" json:"pending_requests,omitempty"` // Nicknames of the characters waiting for our answer
+}
+
+func (x *FriendsList) Reset() {
+	*x = FriendsList{}
+	mi := &file_packets_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendsList) ProtoMessage() {}
+
+func (x *FriendsList) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetSwitchWeapon() *SwitchWeapon {
-	if x, ok := x.GetPayload().(*Packet_SwitchWeapon); ok {
-		return x.SwitchWeapon
+// Deprecated: Use FriendsList.ProtoReflect.Descriptor instead.
+func (*FriendsList) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FriendsList) GetFriends() []*FriendInfo {
+	if x != nil {
+		return x.Friends
 	}
 	return nil
 }
 
-func (x *Packet) GetReloadWeapon() *ReloadWeapon {
-	if x, ok := x.GetPayload().(*Packet_ReloadWeapon); ok {
-		return x.ReloadWeapon
+func (x *FriendsList) GetPendingRequests() []string {
+	if x != nil {
+		return x.PendingRequests
 	}
 	return nil
 }
 
-func (x *Packet) GetRaiseWeapon() *RaiseWeapon {
+type FriendStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	IsOnline bool   `protobuf:"varint,2,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	RegionId uint64 `protobuf:"varint,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"` // Region the friend is at (0 if offline)
+}
+
+func (x *FriendStatus) Reset() {
+	*x = FriendStatus{}
+	mi := &file_packets_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendStatus) ProtoMessage() {}
+
+func (x *FriendStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendStatus.ProtoReflect.Descriptor instead.
+func (*FriendStatus) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FriendStatus) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *FriendStatus) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+func (x *FriendStatus) GetRegionId() uint64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+// Guilds
+type GuildCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag  string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *GuildCreate) Reset() {
+	*x = GuildCreate{}
+	mi := &file_packets_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildCreate) ProtoMessage() {}
+
+func (x *GuildCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildCreate.ProtoReflect.Descriptor instead.
+func (*GuildCreate) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GuildCreate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildCreate) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type GuildDisband struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GuildDisband) Reset() {
+	*x = GuildDisband{}
+	mi := &file_packets_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildDisband) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildDisband) ProtoMessage() {}
+
+func (x *GuildDisband) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildDisband.ProtoReflect.Descriptor instead.
+func (*GuildDisband) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{42}
+}
+
+type GuildInvite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname  string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`                    // Invited character (client) or inviter (server)
+	GuildName string `protobuf:"bytes,2,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"` // Only set by the server
+}
+
+func (x *GuildInvite) Reset() {
+	*x = GuildInvite{}
+	mi := &file_packets_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildInvite) ProtoMessage() {}
+
+func (x *GuildInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildInvite.ProtoReflect.Descriptor instead.
+func (*GuildInvite) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GuildInvite) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GuildInvite) GetGuildName() string {
+	if x != nil {
+		return x.GuildName
+	}
+	return ""
+}
+
+type GuildInviteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accept bool `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *GuildInviteResponse) Reset() {
+	*x = GuildInviteResponse{}
+	mi := &file_packets_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildInviteResponse) ProtoMessage() {}
+
+func (x *GuildInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildInviteResponse.ProtoReflect.Descriptor instead.
+func (*GuildInviteResponse) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GuildInviteResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type GuildLeave struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GuildLeave) Reset() {
+	*x = GuildLeave{}
+	mi := &file_packets_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildLeave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildLeave) ProtoMessage() {}
+
+func (x *GuildLeave) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildLeave.ProtoReflect.Descriptor instead.
+func (*GuildLeave) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{45}
+}
+
+type GuildKick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+}
+
+func (x *GuildKick) Reset() {
+	*x = GuildKick{}
+	mi := &file_packets_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildKick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildKick) ProtoMessage() {}
+
+func (x *GuildKick) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildKick.ProtoReflect.Descriptor instead.
+func (*GuildKick) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GuildKick) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type GuildSetRank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Rank     uint64 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *GuildSetRank) Reset() {
+	*x = GuildSetRank{}
+	mi := &file_packets_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildSetRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildSetRank) ProtoMessage() {}
+
+func (x *GuildSetRank) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildSetRank.ProtoReflect.Descriptor instead.
+func (*GuildSetRank) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GuildSetRank) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GuildSetRank) GetRank() uint64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type GuildEditRank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank        uint64 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions uint64 `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *GuildEditRank) Reset() {
+	*x = GuildEditRank{}
+	mi := &file_packets_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildEditRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildEditRank) ProtoMessage() {}
+
+func (x *GuildEditRank) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildEditRank.ProtoReflect.Descriptor instead.
+func (*GuildEditRank) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GuildEditRank) GetRank() uint64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GuildEditRank) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildEditRank) GetPermissions() uint64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type GuildRank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank        uint64 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions uint64 `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"` // Bitmask of guild permissions
+}
+
+func (x *GuildRank) Reset() {
+	*x = GuildRank{}
+	mi := &file_packets_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildRank) ProtoMessage() {}
+
+func (x *GuildRank) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildRank.ProtoReflect.Descriptor instead.
+func (*GuildRank) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GuildRank) GetRank() uint64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GuildRank) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildRank) GetPermissions() uint64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type GuildMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Rank     uint64 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	IsOnline bool   `protobuf:"varint,3,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+}
+
+func (x *GuildMember) Reset() {
+	*x = GuildMember{}
+	mi := &file_packets_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildMember) ProtoMessage() {}
+
+func (x *GuildMember) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildMember.ProtoReflect.Descriptor instead.
+func (*GuildMember) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GuildMember) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GuildMember) GetRank() uint64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GuildMember) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+type GuildRosterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GuildRosterRequest) Reset() {
+	*x = GuildRosterRequest{}
+	mi := &file_packets_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildRosterRequest) ProtoMessage() {}
+
+func (x *GuildRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildRosterRequest.ProtoReflect.Descriptor instead.
+func (*GuildRosterRequest) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{51}
+}
+
+type GuildRoster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag     string         `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Ranks   []*GuildRank   `protobuf:"bytes,3,rep,name=ranks,proto3" json:"ranks,omitempty"`
+	Members []*GuildMember `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GuildRoster) Reset() {
+	*x = GuildRoster{}
+	mi := &file_packets_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildRoster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildRoster) ProtoMessage() {}
+
+func (x *GuildRoster) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildRoster.ProtoReflect.Descriptor instead.
+func (*GuildRoster) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GuildRoster) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildRoster) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GuildRoster) GetRanks() []*GuildRank {
+	if x != nil {
+		return x.Ranks
+	}
+	return nil
+}
+
+func (x *GuildRoster) GetMembers() []*GuildMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GuildMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *GuildMessage) Reset() {
+	*x = GuildMessage{}
+	mi := &file_packets_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildMessage) ProtoMessage() {}
+
+func (x *GuildMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildMessage.ProtoReflect.Descriptor instead.
+func (*GuildMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GuildMessage) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GuildMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type UpdateGuildTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildTag string `protobuf:"bytes,1,opt,name=guild_tag,json=guildTag,proto3" json:"guild_tag,omitempty"`
+}
+
+func (x *UpdateGuildTag) Reset() {
+	*x = UpdateGuildTag{}
+	mi := &file_packets_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuildTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuildTag) ProtoMessage() {}
+
+func (x *UpdateGuildTag) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuildTag.ProtoReflect.Descriptor instead.
+func (*UpdateGuildTag) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateGuildTag) GetGuildTag() string {
+	if x != nil {
+		return x.GuildTag
+	}
+	return ""
+}
+
+// Inventory
+type InventoryItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot          uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ItemName      string `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	DisplayName   string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	ItemType      string `protobuf:"bytes,4,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"` // consumable, ammo, armor, key, resource, weapon
+	Quantity      uint64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Durability    uint64 `protobuf:"varint,6,opt,name=durability,proto3" json:"durability,omitempty"`                            // Only for armor
+	MaxDurability uint64 `protobuf:"varint,7,opt,name=max_durability,json=maxDurability,proto3" json:"max_durability,omitempty"` // Only for armor
+	Ammo          uint64 `protobuf:"varint,8,opt,name=ammo,proto3" json:"ammo,omitempty"`                                        // Only for weapons, bullets they were stored with
+}
+
+func (x *InventoryItem) Reset() {
+	*x = InventoryItem{}
+	mi := &file_packets_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryItem) ProtoMessage() {}
+
+func (x *InventoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
+func (*InventoryItem) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InventoryItem) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetDurability() uint64 {
+	if x != nil {
+		return x.Durability
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetMaxDurability() uint64 {
+	if x != nil {
+		return x.MaxDurability
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetAmmo() uint64 {
+	if x != nil {
+		return x.Ammo
+	}
+	return 0
+}
+
+type InventorySnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items     []*InventoryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                           // Only occupied slots
+	Capacity  uint64           `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`                    // Total number of slots
+	Weight    uint64           `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`                        // Grams
+	MaxWeight uint64           `protobuf:"varint,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"` // Grams
+}
+
+func (x *InventorySnapshot) Reset() {
+	*x = InventorySnapshot{}
+	mi := &file_packets_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventorySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventorySnapshot) ProtoMessage() {}
+
+func (x *InventorySnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventorySnapshot.ProtoReflect.Descriptor instead.
+func (*InventorySnapshot) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *InventorySnapshot) GetItems() []*InventoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *InventorySnapshot) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *InventorySnapshot) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *InventorySnapshot) GetMaxWeight() uint64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+type InventoryMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromSlot uint64 `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3" json:"from_slot,omitempty"`
+	ToSlot   uint64 `protobuf:"varint,2,opt,name=to_slot,json=toSlot,proto3" json:"to_slot,omitempty"`
+	Quantity uint64 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // 0 moves the whole stack
+}
+
+func (x *InventoryMove) Reset() {
+	*x = InventoryMove{}
+	mi := &file_packets_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryMove) ProtoMessage() {}
+
+func (x *InventoryMove) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryMove.ProtoReflect.Descriptor instead.
+func (*InventoryMove) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *InventoryMove) GetFromSlot() uint64 {
+	if x != nil {
+		return x.FromSlot
+	}
+	return 0
+}
+
+func (x *InventoryMove) GetToSlot() uint64 {
+	if x != nil {
+		return x.ToSlot
+	}
+	return 0
+}
+
+func (x *InventoryMove) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type InventoryUse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+}
+
+func (x *InventoryUse) Reset() {
+	*x = InventoryUse{}
+	mi := &file_packets_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryUse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryUse) ProtoMessage() {}
+
+func (x *InventoryUse) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryUse.ProtoReflect.Descriptor instead.
+func (*InventoryUse) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *InventoryUse) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type InventoryDrop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot     uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Quantity uint64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // 0 drops the whole stack
+}
+
+func (x *InventoryDrop) Reset() {
+	*x = InventoryDrop{}
+	mi := &file_packets_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryDrop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryDrop) ProtoMessage() {}
+
+func (x *InventoryDrop) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryDrop.ProtoReflect.Descriptor instead.
+func (*InventoryDrop) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *InventoryDrop) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *InventoryDrop) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type InventoryEquip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot       uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"` // Inventory slot
+	WeaponSlot uint64 `protobuf:"varint,2,opt,name=weapon_slot,json=weaponSlot,proto3" json:"weapon_slot,omitempty"`
+}
+
+func (x *InventoryEquip) Reset() {
+	*x = InventoryEquip{}
+	mi := &file_packets_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryEquip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryEquip) ProtoMessage() {}
+
+func (x *InventoryEquip) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryEquip.ProtoReflect.Descriptor instead.
+func (*InventoryEquip) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *InventoryEquip) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *InventoryEquip) GetWeaponSlot() uint64 {
+	if x != nil {
+		return x.WeaponSlot
+	}
+	return 0
+}
+
+type InventoryUnequip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WeaponSlot uint64 `protobuf:"varint,1,opt,name=weapon_slot,json=weaponSlot,proto3" json:"weapon_slot,omitempty"`
+}
+
+func (x *InventoryUnequip) Reset() {
+	*x = InventoryUnequip{}
+	mi := &file_packets_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryUnequip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryUnequip) ProtoMessage() {}
+
+func (x *InventoryUnequip) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryUnequip.ProtoReflect.Descriptor instead.
+func (*InventoryUnequip) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *InventoryUnequip) GetWeaponSlot() uint64 {
+	if x != nil {
+		return x.WeaponSlot
+	}
+	return 0
+}
+
+type UpdateWeaponSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weapon *WeaponSlot `protobuf:"bytes,1,opt,name=weapon,proto3" json:"weapon,omitempty"`
+}
+
+func (x *UpdateWeaponSlot) Reset() {
+	*x = UpdateWeaponSlot{}
+	mi := &file_packets_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWeaponSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWeaponSlot) ProtoMessage() {}
+
+func (x *UpdateWeaponSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWeaponSlot.ProtoReflect.Descriptor instead.
+func (*UpdateWeaponSlot) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateWeaponSlot) GetWeapon() *WeaponSlot {
+	if x != nil {
+		return x.Weapon
+	}
+	return nil
+}
+
+// Armor
+type ArmorPiece struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot          uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"` // 0: helmet, 1: vest
+	ItemName      string `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	DisplayName   string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Durability    uint64 `protobuf:"varint,4,opt,name=durability,proto3" json:"durability,omitempty"`
+	MaxDurability uint64 `protobuf:"varint,5,opt,name=max_durability,json=maxDurability,proto3" json:"max_durability,omitempty"`
+}
+
+func (x *ArmorPiece) Reset() {
+	*x = ArmorPiece{}
+	mi := &file_packets_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmorPiece) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmorPiece) ProtoMessage() {}
+
+func (x *ArmorPiece) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmorPiece.ProtoReflect.Descriptor instead.
+func (*ArmorPiece) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ArmorPiece) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ArmorPiece) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *ArmorPiece) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ArmorPiece) GetDurability() uint64 {
+	if x != nil {
+		return x.Durability
+	}
+	return 0
+}
+
+func (x *ArmorPiece) GetMaxDurability() uint64 {
+	if x != nil {
+		return x.MaxDurability
+	}
+	return 0
+}
+
+type ArmorSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pieces []*ArmorPiece `protobuf:"bytes,1,rep,name=pieces,proto3" json:"pieces,omitempty"`
+}
+
+func (x *ArmorSnapshot) Reset() {
+	*x = ArmorSnapshot{}
+	mi := &file_packets_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmorSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmorSnapshot) ProtoMessage() {}
+
+func (x *ArmorSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmorSnapshot.ProtoReflect.Descriptor instead.
+func (*ArmorSnapshot) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ArmorSnapshot) GetPieces() []*ArmorPiece {
+	if x != nil {
+		return x.Pieces
+	}
+	return nil
+}
+
+type ArmorEquip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+}
+
+func (x *ArmorEquip) Reset() {
+	*x = ArmorEquip{}
+	mi := &file_packets_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmorEquip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmorEquip) ProtoMessage() {}
+
+func (x *ArmorEquip) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmorEquip.ProtoReflect.Descriptor instead.
+func (*ArmorEquip) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ArmorEquip) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type ArmorUnequip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArmorSlot uint64 `protobuf:"varint,1,opt,name=armor_slot,json=armorSlot,proto3" json:"armor_slot,omitempty"`
+}
+
+func (x *ArmorUnequip) Reset() {
+	*x = ArmorUnequip{}
+	mi := &file_packets_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmorUnequip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmorUnequip) ProtoMessage() {}
+
+func (x *ArmorUnequip) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmorUnequip.ProtoReflect.Descriptor instead.
+func (*ArmorUnequip) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ArmorUnequip) GetArmorSlot() uint64 {
+	if x != nil {
+		return x.ArmorSlot
+	}
+	return 0
+}
+
+// Status effects
+type StatusEffectApplied struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetId         uint64  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	EffectName       string  `protobuf:"bytes,2,opt,name=effect_name,json=effectName,proto3" json:"effect_name,omitempty"`
+	DisplayName      string  `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Stacks           uint64  `protobuf:"varint,4,opt,name=stacks,proto3" json:"stacks,omitempty"`
+	DurationMs       uint64  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`                    // Time left until it expires
+	SpeedModifier    float64 `protobuf:"fixed64,6,opt,name=speed_modifier,json=speedModifier,proto3" json:"speed_modifier,omitempty"`          // Multiplier from every active effect
+	AccuracyModifier float64 `protobuf:"fixed64,7,opt,name=accuracy_modifier,json=accuracyModifier,proto3" json:"accuracy_modifier,omitempty"` // Multiplier from every active effect, the client widens its spread
+	IsStunned        bool    `protobuf:"varint,8,opt,name=is_stunned,json=isStunned,proto3" json:"is_stunned,omitempty"`                       // Can't move or shoot
+}
+
+func (x *StatusEffectApplied) Reset() {
+	*x = StatusEffectApplied{}
+	mi := &file_packets_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusEffectApplied) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEffectApplied) ProtoMessage() {}
+
+func (x *StatusEffectApplied) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEffectApplied.ProtoReflect.Descriptor instead.
+func (*StatusEffectApplied) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StatusEffectApplied) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *StatusEffectApplied) GetEffectName() string {
+	if x != nil {
+		return x.EffectName
+	}
+	return ""
+}
+
+func (x *StatusEffectApplied) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *StatusEffectApplied) GetStacks() uint64 {
+	if x != nil {
+		return x.Stacks
+	}
+	return 0
+}
+
+func (x *StatusEffectApplied) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *StatusEffectApplied) GetSpeedModifier() float64 {
+	if x != nil {
+		return x.SpeedModifier
+	}
+	return 0
+}
+
+func (x *StatusEffectApplied) GetAccuracyModifier() float64 {
+	if x != nil {
+		return x.AccuracyModifier
+	}
+	return 0
+}
+
+func (x *StatusEffectApplied) GetIsStunned() bool {
+	if x != nil {
+		return x.IsStunned
+	}
+	return false
+}
+
+type StatusEffectExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetId         uint64  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	EffectName       string  `protobuf:"bytes,2,opt,name=effect_name,json=effectName,proto3" json:"effect_name,omitempty"`
+	SpeedModifier    float64 `protobuf:"fixed64,3,opt,name=speed_modifier,json=speedModifier,proto3" json:"speed_modifier,omitempty"`
+	AccuracyModifier float64 `protobuf:"fixed64,4,opt,name=accuracy_modifier,json=accuracyModifier,proto3" json:"accuracy_modifier,omitempty"`
+	IsStunned        bool    `protobuf:"varint,5,opt,name=is_stunned,json=isStunned,proto3" json:"is_stunned,omitempty"`
+}
+
+func (x *StatusEffectExpired) Reset() {
+	*x = StatusEffectExpired{}
+	mi := &file_packets_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusEffectExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEffectExpired) ProtoMessage() {}
+
+func (x *StatusEffectExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEffectExpired.ProtoReflect.Descriptor instead.
+func (*StatusEffectExpired) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StatusEffectExpired) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *StatusEffectExpired) GetEffectName() string {
+	if x != nil {
+		return x.EffectName
+	}
+	return ""
+}
+
+func (x *StatusEffectExpired) GetSpeedModifier() float64 {
+	if x != nil {
+		return x.SpeedModifier
+	}
+	return 0
+}
+
+func (x *StatusEffectExpired) GetAccuracyModifier() float64 {
+	if x != nil {
+		return x.AccuracyModifier
+	}
+	return 0
+}
+
+func (x *StatusEffectExpired) GetIsStunned() bool {
+	if x != nil {
+		return x.IsStunned
+	}
+	return false
+}
+
+// Hazards
+type HazardZone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	HazardType string      `protobuf:"bytes,2,opt,name=hazard_type,json=hazardType,proto3" json:"hazard_type,omitempty"` // gas, radiation, fire
+	Cells      []*Position `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *HazardZone) Reset() {
+	*x = HazardZone{}
+	mi := &file_packets_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HazardZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HazardZone) ProtoMessage() {}
+
+func (x *HazardZone) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HazardZone.ProtoReflect.Descriptor instead.
+func (*HazardZone) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *HazardZone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HazardZone) GetHazardType() string {
+	if x != nil {
+		return x.HazardType
+	}
+	return ""
+}
+
+func (x *HazardZone) GetCells() []*Position {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type HazardZones struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Zones []*HazardZone `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+}
+
+func (x *HazardZones) Reset() {
+	*x = HazardZones{}
+	mi := &file_packets_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HazardZones) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HazardZones) ProtoMessage() {}
+
+func (x *HazardZones) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HazardZones.ProtoReflect.Descriptor instead.
+func (*HazardZones) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *HazardZones) GetZones() []*HazardZone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+// Ground items
+type SpawnGroundItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemName    string    `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	DisplayName string    `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Quantity    uint64    `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Position    *Position `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *SpawnGroundItem) Reset() {
+	*x = SpawnGroundItem{}
+	mi := &file_packets_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpawnGroundItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnGroundItem) ProtoMessage() {}
+
+func (x *SpawnGroundItem) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnGroundItem.ProtoReflect.Descriptor instead.
+func (*SpawnGroundItem) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SpawnGroundItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpawnGroundItem) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *SpawnGroundItem) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *SpawnGroundItem) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SpawnGroundItem) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type DespawnGroundItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DespawnGroundItem) Reset() {
+	*x = DespawnGroundItem{}
+	mi := &file_packets_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DespawnGroundItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DespawnGroundItem) ProtoMessage() {}
+
+func (x *DespawnGroundItem) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DespawnGroundItem.ProtoReflect.Descriptor instead.
+func (*DespawnGroundItem) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DespawnGroundItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PickupGroundItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PickupGroundItem) Reset() {
+	*x = PickupGroundItem{}
+	mi := &file_packets_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupGroundItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupGroundItem) ProtoMessage() {}
+
+func (x *PickupGroundItem) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupGroundItem.ProtoReflect.Descriptor instead.
+func (*PickupGroundItem) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PickupGroundItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Consumables
+type ItemUseStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemName   string `protobuf:"bytes,1,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	DurationMs uint64 `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // Time until the item takes effect
+}
+
+func (x *ItemUseStarted) Reset() {
+	*x = ItemUseStarted{}
+	mi := &file_packets_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemUseStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemUseStarted) ProtoMessage() {}
+
+func (x *ItemUseStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemUseStarted.ProtoReflect.Descriptor instead.
+func (*ItemUseStarted) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ItemUseStarted) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *ItemUseStarted) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type ItemUseEnded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemName  string `protobuf:"bytes,1,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Completed bool   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"` // False if the use was interrupted
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`        // Why it was interrupted (damage, movement, cancelled)
+}
+
+func (x *ItemUseEnded) Reset() {
+	*x = ItemUseEnded{}
+	mi := &file_packets_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemUseEnded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemUseEnded) ProtoMessage() {}
+
+func (x *ItemUseEnded) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemUseEnded.ProtoReflect.Descriptor instead.
+func (*ItemUseEnded) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ItemUseEnded) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *ItemUseEnded) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ItemUseEnded) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelItemUse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelItemUse) Reset() {
+	*x = CancelItemUse{}
+	mi := &file_packets_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelItemUse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelItemUse) ProtoMessage() {}
+
+func (x *CancelItemUse) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelItemUse.ProtoReflect.Descriptor instead.
+func (*CancelItemUse) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{76}
+}
+
+type HealPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetId uint64 `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Amount   uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Health actually restored
+	Health   uint64 `protobuf:"varint,3,opt,name=health,proto3" json:"health,omitempty"` // Health after healing
+	Source   string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`  // Item name or effect that healed the character
+}
+
+func (x *HealPlayer) Reset() {
+	*x = HealPlayer{}
+	mi := &file_packets_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealPlayer) ProtoMessage() {}
+
+func (x *HealPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealPlayer.ProtoReflect.Descriptor instead.
+func (*HealPlayer) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *HealPlayer) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *HealPlayer) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *HealPlayer) GetHealth() uint64 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *HealPlayer) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// NPCs
+type SpawnNpc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            // NPC ids never collide with client ids
+	Template    string    `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"` // raider, scourge_hound
+	Name        string    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position    *Position `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	RotationY   float64   `protobuf:"fixed64,5,opt,name=rotation_y,json=rotationY,proto3" json:"rotation_y,omitempty"`
+	Health      uint64    `protobuf:"varint,6,opt,name=health,proto3" json:"health,omitempty"`
+	MaxHealth   uint64    `protobuf:"varint,7,opt,name=max_health,json=maxHealth,proto3" json:"max_health,omitempty"`
+	WeaponName  string    `protobuf:"bytes,8,opt,name=weapon_name,json=weaponName,proto3" json:"weapon_name,omitempty"`
+	IsCrouching bool      `protobuf:"varint,9,opt,name=is_crouching,json=isCrouching,proto3" json:"is_crouching,omitempty"`
+}
+
+func (x *SpawnNpc) Reset() {
+	*x = SpawnNpc{}
+	mi := &file_packets_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpawnNpc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnNpc) ProtoMessage() {}
+
+func (x *SpawnNpc) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnNpc.ProtoReflect.Descriptor instead.
+func (*SpawnNpc) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SpawnNpc) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpawnNpc) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *SpawnNpc) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpawnNpc) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *SpawnNpc) GetRotationY() float64 {
+	if x != nil {
+		return x.RotationY
+	}
+	return 0
+}
+
+func (x *SpawnNpc) GetHealth() uint64 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *SpawnNpc) GetMaxHealth() uint64 {
+	if x != nil {
+		return x.MaxHealth
+	}
+	return 0
+}
+
+func (x *SpawnNpc) GetWeaponName() string {
+	if x != nil {
+		return x.WeaponName
+	}
+	return ""
+}
+
+func (x *SpawnNpc) GetIsCrouching() bool {
+	if x != nil {
+		return x.IsCrouching
+	}
+	return false
+}
+
+type MoveNpc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position    *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	RotationY   float64   `protobuf:"fixed64,3,opt,name=rotation_y,json=rotationY,proto3" json:"rotation_y,omitempty"`
+	IsCrouching bool      `protobuf:"varint,4,opt,name=is_crouching,json=isCrouching,proto3" json:"is_crouching,omitempty"`
+}
+
+func (x *MoveNpc) Reset() {
+	*x = MoveNpc{}
+	mi := &file_packets_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveNpc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveNpc) ProtoMessage() {}
+
+func (x *MoveNpc) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveNpc.ProtoReflect.Descriptor instead.
+func (*MoveNpc) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *MoveNpc) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveNpc) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *MoveNpc) GetRotationY() float64 {
+	if x != nil {
+		return x.RotationY
+	}
+	return 0
+}
+
+func (x *MoveNpc) GetIsCrouching() bool {
+	if x != nil {
+		return x.IsCrouching
+	}
+	return false
+}
+
+type NpcFire struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetId uint64 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Hit      bool   `protobuf:"varint,3,opt,name=hit,proto3" json:"hit,omitempty"`
+}
+
+func (x *NpcFire) Reset() {
+	*x = NpcFire{}
+	mi := &file_packets_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NpcFire) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NpcFire) ProtoMessage() {}
+
+func (x *NpcFire) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NpcFire.ProtoReflect.Descriptor instead.
+func (*NpcFire) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *NpcFire) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NpcFire) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *NpcFire) GetHit() bool {
+	if x != nil {
+		return x.Hit
+	}
+	return false
+}
+
+type NpcDied struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttackerId uint64 `protobuf:"varint,2,opt,name=attacker_id,json=attackerId,proto3" json:"attacker_id,omitempty"`
+}
+
+func (x *NpcDied) Reset() {
+	*x = NpcDied{}
+	mi := &file_packets_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NpcDied) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NpcDied) ProtoMessage() {}
+
+func (x *NpcDied) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NpcDied.ProtoReflect.Descriptor instead.
+func (*NpcDied) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *NpcDied) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NpcDied) GetAttackerId() uint64 {
+	if x != nil {
+		return x.AttackerId
+	}
+	return 0
+}
+
+type DespawnNpc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DespawnNpc) Reset() {
+	*x = DespawnNpc{}
+	mi := &file_packets_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DespawnNpc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DespawnNpc) ProtoMessage() {}
+
+func (x *DespawnNpc) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DespawnNpc.ProtoReflect.Descriptor instead.
+func (*DespawnNpc) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DespawnNpc) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Matches
+type MatchState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode         string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`                                   // deathmatch, team_deathmatch
+	Phase        string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`                                 // lobby, warmup, live, ended
+	SecondsLeft  uint64 `protobuf:"varint,3,opt,name=seconds_left,json=secondsLeft,proto3" json:"seconds_left,omitempty"` // Time left in this phase (0 if it has no limit)
+	ScoreLimit   uint64 `protobuf:"varint,4,opt,name=score_limit,json=scoreLimit,proto3" json:"score_limit,omitempty"`
+	Team         uint64 `protobuf:"varint,5,opt,name=team,proto3" json:"team,omitempty"` // Our team (0 in deathmatch)
+	FriendlyFire bool   `protobuf:"varint,6,opt,name=friendly_fire,json=friendlyFire,proto3" json:"friendly_fire,omitempty"`
+}
+
+func (x *MatchState) Reset() {
+	*x = MatchState{}
+	mi := &file_packets_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchState) ProtoMessage() {}
+
+func (x *MatchState) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchState.ProtoReflect.Descriptor instead.
+func (*MatchState) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *MatchState) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MatchState) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *MatchState) GetSecondsLeft() uint64 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
+func (x *MatchState) GetScoreLimit() uint64 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *MatchState) GetTeam() uint64 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *MatchState) GetFriendlyFire() bool {
+	if x != nil {
+		return x.FriendlyFire
+	}
+	return false
+}
+
+type MatchPlayerScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Client id of the player
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Team   uint64 `protobuf:"varint,3,opt,name=team,proto3" json:"team,omitempty"`
+	Kills  uint64 `protobuf:"varint,4,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths uint64 `protobuf:"varint,5,opt,name=deaths,proto3" json:"deaths,omitempty"`
+}
+
+func (x *MatchPlayerScore) Reset() {
+	*x = MatchPlayerScore{}
+	mi := &file_packets_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchPlayerScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchPlayerScore) ProtoMessage() {}
+
+func (x *MatchPlayerScore) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchPlayerScore.ProtoReflect.Descriptor instead.
+func (*MatchPlayerScore) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MatchPlayerScore) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MatchPlayerScore) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MatchPlayerScore) GetTeam() uint64 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *MatchPlayerScore) GetKills() uint64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *MatchPlayerScore) GetDeaths() uint64 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+type Scoreboard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase       string              `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Players     []*MatchPlayerScore `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`                                 // Sorted from best to worst
+	TeamScores  []uint64            `protobuf:"varint,3,rep,packed,name=team_scores,json=teamScores,proto3" json:"team_scores,omitempty"` // Score of each team starting from team 1 (empty in deathmatch)
+	SecondsLeft uint64              `protobuf:"varint,4,opt,name=seconds_left,json=secondsLeft,proto3" json:"seconds_left,omitempty"`
+	WinnerId    uint64              `protobuf:"varint,5,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`       // Client id of the winner once the match ended in deathmatch (0 if tied)
+	WinnerTeam  uint64              `protobuf:"varint,6,opt,name=winner_team,json=winnerTeam,proto3" json:"winner_team,omitempty"` // Winning team once the match ended in team deathmatch (0 if tied)
+}
+
+func (x *Scoreboard) Reset() {
+	*x = Scoreboard{}
+	mi := &file_packets_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scoreboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scoreboard) ProtoMessage() {}
+
+func (x *Scoreboard) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scoreboard.ProtoReflect.Descriptor instead.
+func (*Scoreboard) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Scoreboard) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Scoreboard) GetPlayers() []*MatchPlayerScore {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Scoreboard) GetTeamScores() []uint64 {
+	if x != nil {
+		return x.TeamScores
+	}
+	return nil
+}
+
+func (x *Scoreboard) GetSecondsLeft() uint64 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
+func (x *Scoreboard) GetWinnerId() uint64 {
+	if x != nil {
+		return x.WinnerId
+	}
+	return 0
+}
+
+func (x *Scoreboard) GetWinnerTeam() uint64 {
+	if x != nil {
+		return x.WinnerTeam
+	}
+	return 0
+}
+
+// Combat stats
+type KillFeed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttackerId   uint64 `protobuf:"varint,1,opt,name=attacker_id,json=attackerId,proto3" json:"attacker_id,omitempty"` // 0 if the environment killed them
+	AttackerName string `protobuf:"bytes,2,opt,name=attacker_name,json=attackerName,proto3" json:"attacker_name,omitempty"`
+	VictimId     uint64 `protobuf:"varint,3,opt,name=victim_id,json=victimId,proto3" json:"victim_id,omitempty"`
+	VictimName   string `protobuf:"bytes,4,opt,name=victim_name,json=victimName,proto3" json:"victim_name,omitempty"`
+	Weapon       string `protobuf:"bytes,5,opt,name=weapon,proto3" json:"weapon,omitempty"`                            // Weapon name, or the hazard or status effect that killed them
+	IsCritical   bool   `protobuf:"varint,6,opt,name=is_critical,json=isCritical,proto3" json:"is_critical,omitempty"` // The killing blow was a headshot
+}
+
+func (x *KillFeed) Reset() {
+	*x = KillFeed{}
+	mi := &file_packets_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillFeed) ProtoMessage() {}
+
+func (x *KillFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillFeed.ProtoReflect.Descriptor instead.
+func (*KillFeed) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *KillFeed) GetAttackerId() uint64 {
+	if x != nil {
+		return x.AttackerId
+	}
+	return 0
+}
+
+func (x *KillFeed) GetAttackerName() string {
+	if x != nil {
+		return x.AttackerName
+	}
+	return ""
+}
+
+func (x *KillFeed) GetVictimId() uint64 {
+	if x != nil {
+		return x.VictimId
+	}
+	return 0
+}
+
+func (x *KillFeed) GetVictimName() string {
+	if x != nil {
+		return x.VictimName
+	}
+	return ""
+}
+
+func (x *KillFeed) GetWeapon() string {
+	if x != nil {
+		return x.Weapon
+	}
+	return ""
+}
+
+func (x *KillFeed) GetIsCritical() bool {
+	if x != nil {
+		return x.IsCritical
+	}
+	return false
+}
+
+type StatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"` // Empty to see our own
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	mi := &file_packets_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *StatsRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type WeaponStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WeaponName  string `protobuf:"bytes,1,opt,name=weapon_name,json=weaponName,proto3" json:"weapon_name,omitempty"`
+	Kills       uint64 `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+	DamageDealt uint64 `protobuf:"varint,3,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+	ShotsFired  uint64 `protobuf:"varint,4,opt,name=shots_fired,json=shotsFired,proto3" json:"shots_fired,omitempty"`
+	Hits        uint64 `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	Headshots   uint64 `protobuf:"varint,6,opt,name=headshots,proto3" json:"headshots,omitempty"`
+}
+
+func (x *WeaponStats) Reset() {
+	*x = WeaponStats{}
+	mi := &file_packets_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeaponStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeaponStats) ProtoMessage() {}
+
+func (x *WeaponStats) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeaponStats.ProtoReflect.Descriptor instead.
+func (*WeaponStats) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *WeaponStats) GetWeaponName() string {
+	if x != nil {
+		return x.WeaponName
+	}
+	return ""
+}
+
+func (x *WeaponStats) GetKills() uint64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *WeaponStats) GetDamageDealt() uint64 {
+	if x != nil {
+		return x.DamageDealt
+	}
+	return 0
+}
+
+func (x *WeaponStats) GetShotsFired() uint64 {
+	if x != nil {
+		return x.ShotsFired
+	}
+	return 0
+}
+
+func (x *WeaponStats) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *WeaponStats) GetHeadshots() uint64 {
+	if x != nil {
+		return x.Headshots
+	}
+	return 0
+}
+
+type StatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname    string         `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Kills       uint64         `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+	NpcKills    uint64         `protobuf:"varint,3,opt,name=npc_kills,json=npcKills,proto3" json:"npc_kills,omitempty"`
+	Deaths      uint64         `protobuf:"varint,4,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	DamageDealt uint64         `protobuf:"varint,5,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+	DamageTaken uint64         `protobuf:"varint,6,opt,name=damage_taken,json=damageTaken,proto3" json:"damage_taken,omitempty"`
+	ShotsFired  uint64         `protobuf:"varint,7,opt,name=shots_fired,json=shotsFired,proto3" json:"shots_fired,omitempty"`
+	Hits        uint64         `protobuf:"varint,8,opt,name=hits,proto3" json:"hits,omitempty"`
+	Headshots   uint64         `protobuf:"varint,9,opt,name=headshots,proto3" json:"headshots,omitempty"`
+	Weapons     []*WeaponStats `protobuf:"bytes,10,rep,name=weapons,proto3" json:"weapons,omitempty"` // Sorted by kills
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	mi := &file_packets_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *StatsResponse) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *StatsResponse) GetKills() uint64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetNpcKills() uint64 {
+	if x != nil {
+		return x.NpcKills
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetDeaths() uint64 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetDamageDealt() uint64 {
+	if x != nil {
+		return x.DamageDealt
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetDamageTaken() uint64 {
+	if x != nil {
+		return x.DamageTaken
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetShotsFired() uint64 {
+	if x != nil {
+		return x.ShotsFired
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetHeadshots() uint64 {
+	if x != nil {
+		return x.Headshots
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetWeapons() []*WeaponStats {
+	if x != nil {
+		return x.Weapons
+	}
+	return nil
+}
+
+// Leaderboards
+type LeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // kills, kd, level, match_wins
+	Page     uint64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`        // Starting from 1
+}
+
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	mi := &file_packets_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *LeaderboardRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *LeaderboardRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank     uint64  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Nickname string  `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Value    float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"` // Kills, kill/death ratio or match wins
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_packets_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *LeaderboardEntry) GetRank() uint64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type LeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category  string              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Page      uint64              `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageCount uint64              `protobuf:"varint,3,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Entries   []*LeaderboardEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	UpdatedAt int64               `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix time of the last refresh
+}
+
+func (x *LeaderboardResponse) Reset() {
+	*x = LeaderboardResponse{}
+	mi := &file_packets_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardResponse) ProtoMessage() {}
+
+func (x *LeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*LeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *LeaderboardResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *LeaderboardResponse) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *LeaderboardResponse) GetPageCount() uint64 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *LeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LeaderboardResponse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Respawn
+type RespawnPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position  *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	RotationY float64   `protobuf:"fixed64,2,opt,name=rotation_y,json=rotationY,proto3" json:"rotation_y,omitempty"`
+	Safety    uint64    `protobuf:"varint,3,opt,name=safety,proto3" json:"safety,omitempty"` // From 0 (enemies right next to it) to 100 (no enemies nearby)
+}
+
+func (x *RespawnPoint) Reset() {
+	*x = RespawnPoint{}
+	mi := &file_packets_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespawnPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespawnPoint) ProtoMessage() {}
+
+func (x *RespawnPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespawnPoint.ProtoReflect.Descriptor instead.
+func (*RespawnPoint) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RespawnPoint) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *RespawnPoint) GetRotationY() float64 {
+	if x != nil {
+		return x.RotationY
+	}
+	return 0
+}
+
+func (x *RespawnPoint) GetSafety() uint64 {
+	if x != nil {
+		return x.Safety
+	}
+	return 0
+}
+
+type RespawnOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecondsLeft uint64          `protobuf:"varint,1,opt,name=seconds_left,json=secondsLeft,proto3" json:"seconds_left,omitempty"` // Time until we can respawn
+	Automatic   bool            `protobuf:"varint,2,opt,name=automatic,proto3" json:"automatic,omitempty"`                        // The server respawns us on its own (matches), no need to send a RespawnRequest
+	Respawners  []*RespawnPoint `protobuf:"bytes,3,rep,name=respawners,proto3" json:"respawners,omitempty"`
+}
+
+func (x *RespawnOptions) Reset() {
+	*x = RespawnOptions{}
+	mi := &file_packets_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespawnOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespawnOptions) ProtoMessage() {}
+
+func (x *RespawnOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespawnOptions.ProtoReflect.Descriptor instead.
+func (*RespawnOptions) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RespawnOptions) GetSecondsLeft() uint64 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
+func (x *RespawnOptions) GetAutomatic() bool {
+	if x != nil {
+		return x.Automatic
+	}
+	return false
+}
+
+func (x *RespawnOptions) GetRespawners() []*RespawnPoint {
+	if x != nil {
+		return x.Respawners
+	}
+	return nil
+}
+
+type RespawnOptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RespawnOptionsRequest) Reset() {
+	*x = RespawnOptionsRequest{}
+	mi := &file_packets_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespawnOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespawnOptionsRequest) ProtoMessage() {}
+
+func (x *RespawnOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespawnOptionsRequest.ProtoReflect.Descriptor instead.
+func (*RespawnOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{95}
+}
+
+type SpawnProtection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Active       bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Milliseconds uint64 `protobuf:"varint,3,opt,name=milliseconds,proto3" json:"milliseconds,omitempty"` // Duration of the protection (0 when it ends)
+}
+
+func (x *SpawnProtection) Reset() {
+	*x = SpawnProtection{}
+	mi := &file_packets_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpawnProtection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnProtection) ProtoMessage() {}
+
+func (x *SpawnProtection) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnProtection.ProtoReflect.Descriptor instead.
+func (*SpawnProtection) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SpawnProtection) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpawnProtection) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SpawnProtection) GetMilliseconds() uint64 {
+	if x != nil {
+		return x.Milliseconds
+	}
+	return 0
+}
+
+// Administration
+type Announcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_packets_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Announcement) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Kicked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *Kicked) Reset() {
+	*x = Kicked{}
+	mi := &file_packets_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Kicked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kicked) ProtoMessage() {}
+
+func (x *Kicked) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kicked.ProtoReflect.Descriptor instead.
+func (*Kicked) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Kicked) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Main Packet container
+type Packet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderId uint64 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// Types that are assignable to Payload:
+	//
+	//	*Packet_PublicMessage
+	//	*Packet_Handshake
+	//	*Packet_Heartbeat
+	//	*Packet_ServerMetrics
+	//	*Packet_RequestGranted
+	//	*Packet_RequestDenied
+	//	*Packet_LoginRequest
+	//	*Packet_RegisterRequest
+	//	*Packet_LoginSuccess
+	//	*Packet_LogoutRequest
+	//	*Packet_ClientEntered
+	//	*Packet_ClientLeft
+	//	*Packet_JoinRegionRequest
+	//	*Packet_RegionData
+	//	*Packet_SpawnCharacter
+	//	*Packet_MoveCharacter
+	//	*Packet_RotateCharacter
+	//	*Packet_Destination
+	//	*Packet_UpdateSpeed
+	//	*Packet_ChatBubble
+	//	*Packet_SwitchWeapon
+	//	*Packet_ReloadWeapon
+	//	*Packet_RaiseWeapon
+	//	*Packet_LowerWeapon
+	//	*Packet_FireWeapon
+	//	*Packet_FireWeaponMultiple
+	//	*Packet_ToggleFireMode
+	//	*Packet_ReportPlayerDamage
+	//	*Packet_ApplyPlayerDamage
+	//	*Packet_PlayerDied
+	//	*Packet_RespawnRequest
+	//	*Packet_CrouchCharacter
+	//	*Packet_FriendRequest
+	//	*Packet_FriendAccept
+	//	*Packet_FriendRemove
+	//	*Packet_FriendsList
+	//	*Packet_FriendStatus
+	//	*Packet_GuildCreate
+	//	*Packet_GuildDisband
+	//	*Packet_GuildInvite
+	//	*Packet_GuildInviteResponse
+	//	*Packet_GuildLeave
+	//	*Packet_GuildKick
+	//	*Packet_GuildSetRank
+	//	*Packet_GuildEditRank
+	//	*Packet_GuildRosterRequest
+	//	*Packet_GuildRoster
+	//	*Packet_GuildMessage
+	//	*Packet_UpdateGuildTag
+	//	*Packet_InventorySnapshot
+	//	*Packet_InventoryMove
+	//	*Packet_InventoryUse
+	//	*Packet_InventoryDrop
+	//	*Packet_InventoryEquip
+	//	*Packet_InventoryUnequip
+	//	*Packet_UpdateWeaponSlot
+	//	*Packet_SpawnGroundItem
+	//	*Packet_DespawnGroundItem
+	//	*Packet_PickupGroundItem
+	//	*Packet_ItemUseStarted
+	//	*Packet_ItemUseEnded
+	//	*Packet_CancelItemUse
+	//	*Packet_HealPlayer
+	//	*Packet_ArmorSnapshot
+	//	*Packet_ArmorEquip
+	//	*Packet_ArmorUnequip
+	//	*Packet_StatusEffectApplied
+	//	*Packet_StatusEffectExpired
+	//	*Packet_HazardZones
+	//	*Packet_SpawnNpc
+	//	*Packet_MoveNpc
+	//	*Packet_NpcFire
+	//	*Packet_NpcDied
+	//	*Packet_DespawnNpc
+	//	*Packet_MatchState
+	//	*Packet_Scoreboard
+	//	*Packet_KillFeed
+	//	*Packet_StatsRequest
+	//	*Packet_StatsResponse
+	//	*Packet_LeaderboardRequest
+	//	*Packet_LeaderboardResponse
+	//	*Packet_RespawnOptions
+	//	*Packet_RespawnOptionsRequest
+	//	*Packet_SpawnProtection
+	//	*Packet_Announcement
+	//	*Packet_Kicked
+	Payload isPacket_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Packet) Reset() {
+	*x = Packet{}
+	mi := &file_packets_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Packet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packet) ProtoMessage() {}
+
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
+func (*Packet) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Packet) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (m *Packet) GetPayload() isPacket_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Packet) GetPublicMessage() *PublicMessage {
+	if x, ok := x.GetPayload().(*Packet_PublicMessage); ok {
+		return x.PublicMessage
+	}
+	return nil
+}
+
+func (x *Packet) GetHandshake() *Handshake {
+	if x, ok := x.GetPayload().(*Packet_Handshake); ok {
+		return x.Handshake
+	}
+	return nil
+}
+
+func (x *Packet) GetHeartbeat() *Heartbeat {
+	if x, ok := x.GetPayload().(*Packet_Heartbeat); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *Packet) GetServerMetrics() *ServerMetrics {
+	if x, ok := x.GetPayload().(*Packet_ServerMetrics); ok {
+		return x.ServerMetrics
+	}
+	return nil
+}
+
+func (x *Packet) GetRequestGranted() *RequestGranted {
+	if x, ok := x.GetPayload().(*Packet_RequestGranted); ok {
+		return x.RequestGranted
+	}
+	return nil
+}
+
+func (x *Packet) GetRequestDenied() *RequestDenied {
+	if x, ok := x.GetPayload().(*Packet_RequestDenied); ok {
+		return x.RequestDenied
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginRequest() *LoginRequest {
+	if x, ok := x.GetPayload().(*Packet_LoginRequest); ok {
+		return x.LoginRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetRegisterRequest() *RegisterRequest {
+	if x, ok := x.GetPayload().(*Packet_RegisterRequest); ok {
+		return x.RegisterRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginSuccess() *LoginSuccess {
+	if x, ok := x.GetPayload().(*Packet_LoginSuccess); ok {
+		return x.LoginSuccess
+	}
+	return nil
+}
+
+func (x *Packet) GetLogoutRequest() *LogoutRequest {
+	if x, ok := x.GetPayload().(*Packet_LogoutRequest); ok {
+		return x.LogoutRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetClientEntered() *ClientEntered {
+	if x, ok := x.GetPayload().(*Packet_ClientEntered); ok {
+		return x.ClientEntered
+	}
+	return nil
+}
+
+func (x *Packet) GetClientLeft() *ClientLeft {
+	if x, ok := x.GetPayload().(*Packet_ClientLeft); ok {
+		return x.ClientLeft
+	}
+	return nil
+}
+
+func (x *Packet) GetJoinRegionRequest() *JoinRegionRequest {
+	if x, ok := x.GetPayload().(*Packet_JoinRegionRequest); ok {
+		return x.JoinRegionRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetRegionData() *RegionData {
+	if x, ok := x.GetPayload().(*Packet_RegionData); ok {
+		return x.RegionData
+	}
+	return nil
+}
+
+func (x *Packet) GetSpawnCharacter() *SpawnCharacter {
+	if x, ok := x.GetPayload().(*Packet_SpawnCharacter); ok {
+		return x.SpawnCharacter
+	}
+	return nil
+}
+
+func (x *Packet) GetMoveCharacter() *MoveCharacter {
+	if x, ok := x.GetPayload().(*Packet_MoveCharacter); ok {
+		return x.MoveCharacter
+	}
+	return nil
+}
+
+func (x *Packet) GetRotateCharacter() *RotateCharacter {
+	if x, ok := x.GetPayload().(*Packet_RotateCharacter); ok {
+		return x.RotateCharacter
+	}
+	return nil
+}
+
+func (x *Packet) GetDestination() *Destination {
+	if x, ok := x.GetPayload().(*Packet_Destination); ok {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *Packet) GetUpdateSpeed() *UpdateSpeed {
+	if x, ok := x.GetPayload().(*Packet_UpdateSpeed); ok {
+		return x.UpdateSpeed
+	}
+	return nil
+}
+
+func (x *Packet) GetChatBubble() *ChatBubble {
+	if x, ok := x.GetPayload().(*Packet_ChatBubble); ok {
+		return x.ChatBubble
+	}
+	return nil
+}
+
+func (x *Packet) GetSwitchWeapon() *SwitchWeapon {
+	if x, ok := x.GetPayload().(*Packet_SwitchWeapon); ok {
+		return x.SwitchWeapon
+	}
+	return nil
+}
+
+func (x *Packet) GetReloadWeapon() *ReloadWeapon {
+	if x, ok := x.GetPayload().(*Packet_ReloadWeapon); ok {
+		return x.ReloadWeapon
+	}
+	return nil
+}
+
+func (x *Packet) GetRaiseWeapon() *RaiseWeapon {
 	if x, ok := x.GetPayload().(*Packet_RaiseWeapon); ok {
 		return x.RaiseWeapon
 	}
 	return nil
 }
 
-func (x *Packet) GetLowerWeapon() *LowerWeapon {
-	if x, ok := x.GetPayload().(*Packet_LowerWeapon); ok {
-		return x.LowerWeapon
+func (x *Packet) GetLowerWeapon() *LowerWeapon {
+	if x, ok := x.GetPayload().(*Packet_LowerWeapon); ok {
+		return x.LowerWeapon
+	}
+	return nil
+}
+
+func (x *Packet) GetFireWeapon() *FireWeapon {
+	if x, ok := x.GetPayload().(*Packet_FireWeapon); ok {
+		return x.FireWeapon
+	}
+	return nil
+}
+
+func (x *Packet) GetFireWeaponMultiple() *FireWeaponMultiple {
+	if x, ok := x.GetPayload().(*Packet_FireWeaponMultiple); ok {
+		return x.FireWeaponMultiple
+	}
+	return nil
+}
+
+func (x *Packet) GetToggleFireMode() *ToggleFireMode {
+	if x, ok := x.GetPayload().(*Packet_ToggleFireMode); ok {
+		return x.ToggleFireMode
+	}
+	return nil
+}
+
+func (x *Packet) GetReportPlayerDamage() *ReportPlayerDamage {
+	if x, ok := x.GetPayload().(*Packet_ReportPlayerDamage); ok {
+		return x.ReportPlayerDamage
+	}
+	return nil
+}
+
+func (x *Packet) GetApplyPlayerDamage() *ApplyPlayerDamage {
+	if x, ok := x.GetPayload().(*Packet_ApplyPlayerDamage); ok {
+		return x.ApplyPlayerDamage
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerDied() *PlayerDied {
+	if x, ok := x.GetPayload().(*Packet_PlayerDied); ok {
+		return x.PlayerDied
+	}
+	return nil
+}
+
+func (x *Packet) GetRespawnRequest() *RespawnRequest {
+	if x, ok := x.GetPayload().(*Packet_RespawnRequest); ok {
+		return x.RespawnRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetCrouchCharacter() *CrouchCharacter {
+	if x, ok := x.GetPayload().(*Packet_CrouchCharacter); ok {
+		return x.CrouchCharacter
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendRequest() *FriendRequest {
+	if x, ok := x.GetPayload().(*Packet_FriendRequest); ok {
+		return x.FriendRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendAccept() *FriendAccept {
+	if x, ok := x.GetPayload().(*Packet_FriendAccept); ok {
+		return x.FriendAccept
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendRemove() *FriendRemove {
+	if x, ok := x.GetPayload().(*Packet_FriendRemove); ok {
+		return x.FriendRemove
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendsList() *FriendsList {
+	if x, ok := x.GetPayload().(*Packet_FriendsList); ok {
+		return x.FriendsList
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendStatus() *FriendStatus {
+	if x, ok := x.GetPayload().(*Packet_FriendStatus); ok {
+		return x.FriendStatus
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildCreate() *GuildCreate {
+	if x, ok := x.GetPayload().(*Packet_GuildCreate); ok {
+		return x.GuildCreate
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildDisband() *GuildDisband {
+	if x, ok := x.GetPayload().(*Packet_GuildDisband); ok {
+		return x.GuildDisband
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildInvite() *GuildInvite {
+	if x, ok := x.GetPayload().(*Packet_GuildInvite); ok {
+		return x.GuildInvite
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildInviteResponse() *GuildInviteResponse {
+	if x, ok := x.GetPayload().(*Packet_GuildInviteResponse); ok {
+		return x.GuildInviteResponse
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildLeave() *GuildLeave {
+	if x, ok := x.GetPayload().(*Packet_GuildLeave); ok {
+		return x.GuildLeave
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildKick() *GuildKick {
+	if x, ok := x.GetPayload().(*Packet_GuildKick); ok {
+		return x.GuildKick
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildSetRank() *GuildSetRank {
+	if x, ok := x.GetPayload().(*Packet_GuildSetRank); ok {
+		return x.GuildSetRank
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildEditRank() *GuildEditRank {
+	if x, ok := x.GetPayload().(*Packet_GuildEditRank); ok {
+		return x.GuildEditRank
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildRosterRequest() *GuildRosterRequest {
+	if x, ok := x.GetPayload().(*Packet_GuildRosterRequest); ok {
+		return x.GuildRosterRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildRoster() *GuildRoster {
+	if x, ok := x.GetPayload().(*Packet_GuildRoster); ok {
+		return x.GuildRoster
+	}
+	return nil
+}
+
+func (x *Packet) GetGuildMessage() *GuildMessage {
+	if x, ok := x.GetPayload().(*Packet_GuildMessage); ok {
+		return x.GuildMessage
+	}
+	return nil
+}
+
+func (x *Packet) GetUpdateGuildTag() *UpdateGuildTag {
+	if x, ok := x.GetPayload().(*Packet_UpdateGuildTag); ok {
+		return x.UpdateGuildTag
+	}
+	return nil
+}
+
+func (x *Packet) GetInventorySnapshot() *InventorySnapshot {
+	if x, ok := x.GetPayload().(*Packet_InventorySnapshot); ok {
+		return x.InventorySnapshot
+	}
+	return nil
+}
+
+func (x *Packet) GetInventoryMove() *InventoryMove {
+	if x, ok := x.GetPayload().(*Packet_InventoryMove); ok {
+		return x.InventoryMove
+	}
+	return nil
+}
+
+func (x *Packet) GetInventoryUse() *InventoryUse {
+	if x, ok := x.GetPayload().(*Packet_InventoryUse); ok {
+		return x.InventoryUse
+	}
+	return nil
+}
+
+func (x *Packet) GetInventoryDrop() *InventoryDrop {
+	if x, ok := x.GetPayload().(*Packet_InventoryDrop); ok {
+		return x.InventoryDrop
+	}
+	return nil
+}
+
+func (x *Packet) GetInventoryEquip() *InventoryEquip {
+	if x, ok := x.GetPayload().(*Packet_InventoryEquip); ok {
+		return x.InventoryEquip
+	}
+	return nil
+}
+
+func (x *Packet) GetInventoryUnequip() *InventoryUnequip {
+	if x, ok := x.GetPayload().(*Packet_InventoryUnequip); ok {
+		return x.InventoryUnequip
+	}
+	return nil
+}
+
+func (x *Packet) GetUpdateWeaponSlot() *UpdateWeaponSlot {
+	if x, ok := x.GetPayload().(*Packet_UpdateWeaponSlot); ok {
+		return x.UpdateWeaponSlot
+	}
+	return nil
+}
+
+func (x *Packet) GetSpawnGroundItem() *SpawnGroundItem {
+	if x, ok := x.GetPayload().(*Packet_SpawnGroundItem); ok {
+		return x.SpawnGroundItem
+	}
+	return nil
+}
+
+func (x *Packet) GetDespawnGroundItem() *DespawnGroundItem {
+	if x, ok := x.GetPayload().(*Packet_DespawnGroundItem); ok {
+		return x.DespawnGroundItem
+	}
+	return nil
+}
+
+func (x *Packet) GetPickupGroundItem() *PickupGroundItem {
+	if x, ok := x.GetPayload().(*Packet_PickupGroundItem); ok {
+		return x.PickupGroundItem
+	}
+	return nil
+}
+
+func (x *Packet) GetItemUseStarted() *ItemUseStarted {
+	if x, ok := x.GetPayload().(*Packet_ItemUseStarted); ok {
+		return x.ItemUseStarted
+	}
+	return nil
+}
+
+func (x *Packet) GetItemUseEnded() *ItemUseEnded {
+	if x, ok := x.GetPayload().(*Packet_ItemUseEnded); ok {
+		return x.ItemUseEnded
+	}
+	return nil
+}
+
+func (x *Packet) GetCancelItemUse() *CancelItemUse {
+	if x, ok := x.GetPayload().(*Packet_CancelItemUse); ok {
+		return x.CancelItemUse
+	}
+	return nil
+}
+
+func (x *Packet) GetHealPlayer() *HealPlayer {
+	if x, ok := x.GetPayload().(*Packet_HealPlayer); ok {
+		return x.HealPlayer
+	}
+	return nil
+}
+
+func (x *Packet) GetArmorSnapshot() *ArmorSnapshot {
+	if x, ok := x.GetPayload().(*Packet_ArmorSnapshot); ok {
+		return x.ArmorSnapshot
+	}
+	return nil
+}
+
+func (x *Packet) GetArmorEquip() *ArmorEquip {
+	if x, ok := x.GetPayload().(*Packet_ArmorEquip); ok {
+		return x.ArmorEquip
+	}
+	return nil
+}
+
+func (x *Packet) GetArmorUnequip() *ArmorUnequip {
+	if x, ok := x.GetPayload().(*Packet_ArmorUnequip); ok {
+		return x.ArmorUnequip
+	}
+	return nil
+}
+
+func (x *Packet) GetStatusEffectApplied() *StatusEffectApplied {
+	if x, ok := x.GetPayload().(*Packet_StatusEffectApplied); ok {
+		return x.StatusEffectApplied
+	}
+	return nil
+}
+
+func (x *Packet) GetStatusEffectExpired() *StatusEffectExpired {
+	if x, ok := x.GetPayload().(*Packet_StatusEffectExpired); ok {
+		return x.StatusEffectExpired
+	}
+	return nil
+}
+
+func (x *Packet) GetHazardZones() *HazardZones {
+	if x, ok := x.GetPayload().(*Packet_HazardZones); ok {
+		return x.HazardZones
+	}
+	return nil
+}
+
+func (x *Packet) GetSpawnNpc() *SpawnNpc {
+	if x, ok := x.GetPayload().(*Packet_SpawnNpc); ok {
+		return x.SpawnNpc
+	}
+	return nil
+}
+
+func (x *Packet) GetMoveNpc() *MoveNpc {
+	if x, ok := x.GetPayload().(*Packet_MoveNpc); ok {
+		return x.MoveNpc
+	}
+	return nil
+}
+
+func (x *Packet) GetNpcFire() *NpcFire {
+	if x, ok := x.GetPayload().(*Packet_NpcFire); ok {
+		return x.NpcFire
+	}
+	return nil
+}
+
+func (x *Packet) GetNpcDied() *NpcDied {
+	if x, ok := x.GetPayload().(*Packet_NpcDied); ok {
+		return x.NpcDied
+	}
+	return nil
+}
+
+func (x *Packet) GetDespawnNpc() *DespawnNpc {
+	if x, ok := x.GetPayload().(*Packet_DespawnNpc); ok {
+		return x.DespawnNpc
+	}
+	return nil
+}
+
+func (x *Packet) GetMatchState() *MatchState {
+	if x, ok := x.GetPayload().(*Packet_MatchState); ok {
+		return x.MatchState
 	}
 	return nil
 }
 
-func (x *Packet) GetFireWeapon() *FireWeapon {
-	if x, ok := x.GetPayload().(*Packet_FireWeapon); ok {
-		return x.FireWeapon
+func (x *Packet) GetScoreboard() *Scoreboard {
+	if x, ok := x.GetPayload().(*Packet_Scoreboard); ok {
+		return x.Scoreboard
 	}
 	return nil
 }
 
-func (x *Packet) GetFireWeaponMultiple() *FireWeaponMultiple {
-	if x, ok := x.GetPayload().(*Packet_FireWeaponMultiple); ok {
-		return x.FireWeaponMultiple
+func (x *Packet) GetKillFeed() *KillFeed {
+	if x, ok := x.GetPayload().(*Packet_KillFeed); ok {
+		return x.KillFeed
 	}
 	return nil
 }
 
-func (x *Packet) GetToggleFireMode() *ToggleFireMode {
-	if x, ok := x.GetPayload().(*Packet_ToggleFireMode); ok {
-		return x.ToggleFireMode
+func (x *Packet) GetStatsRequest() *StatsRequest {
+	if x, ok := x.GetPayload().(*Packet_StatsRequest); ok {
+		return x.StatsRequest
 	}
 	return nil
 }
 
-func (x *Packet) GetReportPlayerDamage() *ReportPlayerDamage {
-	if x, ok := x.GetPayload().(*Packet_ReportPlayerDamage); ok {
-		return x.ReportPlayerDamage
+func (x *Packet) GetStatsResponse() *StatsResponse {
+	if x, ok := x.GetPayload().(*Packet_StatsResponse); ok {
+		return x.StatsResponse
 	}
 	return nil
 }
 
-func (x *Packet) GetApplyPlayerDamage() *ApplyPlayerDamage {
-	if x, ok := x.GetPayload().(*Packet_ApplyPlayerDamage); ok {
-		return x.ApplyPlayerDamage
+func (x *Packet) GetLeaderboardRequest() *LeaderboardRequest {
+	if x, ok := x.GetPayload().(*Packet_LeaderboardRequest); ok {
+		return x.LeaderboardRequest
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerDied() *PlayerDied {
-	if x, ok := x.GetPayload().(*Packet_PlayerDied); ok {
-		return x.PlayerDied
+func (x *Packet) GetLeaderboardResponse() *LeaderboardResponse {
+	if x, ok := x.GetPayload().(*Packet_LeaderboardResponse); ok {
+		return x.LeaderboardResponse
 	}
 	return nil
 }
 
-func (x *Packet) GetRespawnRequest() *RespawnRequest {
-	if x, ok := x.GetPayload().(*Packet_RespawnRequest); ok {
-		return x.RespawnRequest
+func (x *Packet) GetRespawnOptions() *RespawnOptions {
+	if x, ok := x.GetPayload().(*Packet_RespawnOptions); ok {
+		return x.RespawnOptions
 	}
 	return nil
 }
 
-func (x *Packet) GetCrouchCharacter() *CrouchCharacter {
-	if x, ok := x.GetPayload().(*Packet_CrouchCharacter); ok {
-		return x.CrouchCharacter
+func (x *Packet) GetRespawnOptionsRequest() *RespawnOptionsRequest {
+	if x, ok := x.GetPayload().(*Packet_RespawnOptionsRequest); ok {
+		return x.RespawnOptionsRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetSpawnProtection() *SpawnProtection {
+	if x, ok := x.GetPayload().(*Packet_SpawnProtection); ok {
+		return x.SpawnProtection
+	}
+	return nil
+}
+
+func (x *Packet) GetAnnouncement() *Announcement {
+	if x, ok := x.GetPayload().(*Packet_Announcement); ok {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *Packet) GetKicked() *Kicked {
+	if x, ok := x.GetPayload().(*Packet_Kicked); ok {
+		return x.Kicked
 	}
 	return nil
 }
@@ -2323,6 +6580,236 @@ type Packet_CrouchCharacter struct {
 	CrouchCharacter *CrouchCharacter `protobuf:"bytes,33,opt,name=crouch_character,json=crouchCharacter,proto3,oneof"` // Both
 }
 
+type Packet_FriendRequest struct {
+	// Friends
+	FriendRequest *FriendRequest `protobuf:"bytes,34,opt,name=friend_request,json=friendRequest,proto3,oneof"` // Both
+}
+
+type Packet_FriendAccept struct {
+	FriendAccept *FriendAccept `protobuf:"bytes,35,opt,name=friend_accept,json=friendAccept,proto3,oneof"` // Client
+}
+
+type Packet_FriendRemove struct {
+	FriendRemove *FriendRemove `protobuf:"bytes,36,opt,name=friend_remove,json=friendRemove,proto3,oneof"` // Client
+}
+
+type Packet_FriendsList struct {
+	FriendsList *FriendsList `protobuf:"bytes,37,opt,name=friends_list,json=friendsList,proto3,oneof"` // Server
+}
+
+type Packet_FriendStatus struct {
+	FriendStatus *FriendStatus `protobuf:"bytes,38,opt,name=friend_status,json=friendStatus,proto3,oneof"` // Server
+}
+
+type Packet_GuildCreate struct {
+	// Guilds
+	GuildCreate *GuildCreate `protobuf:"bytes,39,opt,name=guild_create,json=guildCreate,proto3,oneof"` // Client
+}
+
+type Packet_GuildDisband struct {
+	GuildDisband *GuildDisband `protobuf:"bytes,40,opt,name=guild_disband,json=guildDisband,proto3,oneof"` // Client
+}
+
+type Packet_GuildInvite struct {
+	GuildInvite *GuildInvite `protobuf:"bytes,41,opt,name=guild_invite,json=guildInvite,proto3,oneof"` // Both
+}
+
+type Packet_GuildInviteResponse struct {
+	GuildInviteResponse *GuildInviteResponse `protobuf:"bytes,42,opt,name=guild_invite_response,json=guildInviteResponse,proto3,oneof"` // Client
+}
+
+type Packet_GuildLeave struct {
+	GuildLeave *GuildLeave `protobuf:"bytes,43,opt,name=guild_leave,json=guildLeave,proto3,oneof"` // Client
+}
+
+type Packet_GuildKick struct {
+	GuildKick *GuildKick `protobuf:"bytes,44,opt,name=guild_kick,json=guildKick,proto3,oneof"` // Client
+}
+
+type Packet_GuildSetRank struct {
+	GuildSetRank *GuildSetRank `protobuf:"bytes,45,opt,name=guild_set_rank,json=guildSetRank,proto3,oneof"` // Client
+}
+
+type Packet_GuildEditRank struct {
+	GuildEditRank *GuildEditRank `protobuf:"bytes,46,opt,name=guild_edit_rank,json=guildEditRank,proto3,oneof"` // Client
+}
+
+type Packet_GuildRosterRequest struct {
+	GuildRosterRequest *GuildRosterRequest `protobuf:"bytes,47,opt,name=guild_roster_request,json=guildRosterRequest,proto3,oneof"` // Client
+}
+
+type Packet_GuildRoster struct {
+	GuildRoster *GuildRoster `protobuf:"bytes,48,opt,name=guild_roster,json=guildRoster,proto3,oneof"` // Server
+}
+
+type Packet_GuildMessage struct {
+	GuildMessage *GuildMessage `protobuf:"bytes,49,opt,name=guild_message,json=guildMessage,proto3,oneof"` // Both
+}
+
+type Packet_UpdateGuildTag struct {
+	UpdateGuildTag *UpdateGuildTag `protobuf:"bytes,50,opt,name=update_guild_tag,json=updateGuildTag,proto3,oneof"` // Server
+}
+
+type Packet_InventorySnapshot struct {
+	// Inventory
+	InventorySnapshot *InventorySnapshot `protobuf:"bytes,51,opt,name=inventory_snapshot,json=inventorySnapshot,proto3,oneof"` // Server
+}
+
+type Packet_InventoryMove struct {
+	InventoryMove *InventoryMove `protobuf:"bytes,52,opt,name=inventory_move,json=inventoryMove,proto3,oneof"` // Client
+}
+
+type Packet_InventoryUse struct {
+	InventoryUse *InventoryUse `protobuf:"bytes,53,opt,name=inventory_use,json=inventoryUse,proto3,oneof"` // Client
+}
+
+type Packet_InventoryDrop struct {
+	InventoryDrop *InventoryDrop `protobuf:"bytes,54,opt,name=inventory_drop,json=inventoryDrop,proto3,oneof"` // Client
+}
+
+type Packet_InventoryEquip struct {
+	InventoryEquip *InventoryEquip `protobuf:"bytes,55,opt,name=inventory_equip,json=inventoryEquip,proto3,oneof"` // Client
+}
+
+type Packet_InventoryUnequip struct {
+	InventoryUnequip *InventoryUnequip `protobuf:"bytes,56,opt,name=inventory_unequip,json=inventoryUnequip,proto3,oneof"` // Client
+}
+
+type Packet_UpdateWeaponSlot struct {
+	UpdateWeaponSlot *UpdateWeaponSlot `protobuf:"bytes,57,opt,name=update_weapon_slot,json=updateWeaponSlot,proto3,oneof"` // Server
+}
+
+type Packet_SpawnGroundItem struct {
+	// Ground items
+	SpawnGroundItem *SpawnGroundItem `protobuf:"bytes,58,opt,name=spawn_ground_item,json=spawnGroundItem,proto3,oneof"` // Server
+}
+
+type Packet_DespawnGroundItem struct {
+	DespawnGroundItem *DespawnGroundItem `protobuf:"bytes,59,opt,name=despawn_ground_item,json=despawnGroundItem,proto3,oneof"` // Server
+}
+
+type Packet_PickupGroundItem struct {
+	PickupGroundItem *PickupGroundItem `protobuf:"bytes,60,opt,name=pickup_ground_item,json=pickupGroundItem,proto3,oneof"` // Client
+}
+
+type Packet_ItemUseStarted struct {
+	// Consumables
+	ItemUseStarted *ItemUseStarted `protobuf:"bytes,61,opt,name=item_use_started,json=itemUseStarted,proto3,oneof"` // Server
+}
+
+type Packet_ItemUseEnded struct {
+	ItemUseEnded *ItemUseEnded `protobuf:"bytes,62,opt,name=item_use_ended,json=itemUseEnded,proto3,oneof"` // Server
+}
+
+type Packet_CancelItemUse struct {
+	CancelItemUse *CancelItemUse `protobuf:"bytes,63,opt,name=cancel_item_use,json=cancelItemUse,proto3,oneof"` // Client
+}
+
+type Packet_HealPlayer struct {
+	HealPlayer *HealPlayer `protobuf:"bytes,64,opt,name=heal_player,json=healPlayer,proto3,oneof"` // Server
+}
+
+type Packet_ArmorSnapshot struct {
+	// Armor
+	ArmorSnapshot *ArmorSnapshot `protobuf:"bytes,65,opt,name=armor_snapshot,json=armorSnapshot,proto3,oneof"` // Server
+}
+
+type Packet_ArmorEquip struct {
+	ArmorEquip *ArmorEquip `protobuf:"bytes,66,opt,name=armor_equip,json=armorEquip,proto3,oneof"` // Client
+}
+
+type Packet_ArmorUnequip struct {
+	ArmorUnequip *ArmorUnequip `protobuf:"bytes,67,opt,name=armor_unequip,json=armorUnequip,proto3,oneof"` // Client
+}
+
+type Packet_StatusEffectApplied struct {
+	// Status effects
+	StatusEffectApplied *StatusEffectApplied `protobuf:"bytes,68,opt,name=status_effect_applied,json=statusEffectApplied,proto3,oneof"` // Server
+}
+
+type Packet_StatusEffectExpired struct {
+	StatusEffectExpired *StatusEffectExpired `protobuf:"bytes,69,opt,name=status_effect_expired,json=statusEffectExpired,proto3,oneof"` // Server
+}
+
+type Packet_HazardZones struct {
+	// Hazards
+	HazardZones *HazardZones `protobuf:"bytes,70,opt,name=hazard_zones,json=hazardZones,proto3,oneof"` // Server
+}
+
+type Packet_SpawnNpc struct {
+	// NPCs
+	SpawnNpc *SpawnNpc `protobuf:"bytes,71,opt,name=spawn_npc,json=spawnNpc,proto3,oneof"` // Server
+}
+
+type Packet_MoveNpc struct {
+	MoveNpc *MoveNpc `protobuf:"bytes,72,opt,name=move_npc,json=moveNpc,proto3,oneof"` // Server
+}
+
+type Packet_NpcFire struct {
+	NpcFire *NpcFire `protobuf:"bytes,73,opt,name=npc_fire,json=npcFire,proto3,oneof"` // Server
+}
+
+type Packet_NpcDied struct {
+	NpcDied *NpcDied `protobuf:"bytes,74,opt,name=npc_died,json=npcDied,proto3,oneof"` // Server
+}
+
+type Packet_DespawnNpc struct {
+	DespawnNpc *DespawnNpc `protobuf:"bytes,75,opt,name=despawn_npc,json=despawnNpc,proto3,oneof"` // Server
+}
+
+type Packet_MatchState struct {
+	// Matches
+	MatchState *MatchState `protobuf:"bytes,76,opt,name=match_state,json=matchState,proto3,oneof"` // Server
+}
+
+type Packet_Scoreboard struct {
+	Scoreboard *Scoreboard `protobuf:"bytes,77,opt,name=scoreboard,proto3,oneof"` // Server
+}
+
+type Packet_KillFeed struct {
+	// Combat stats
+	KillFeed *KillFeed `protobuf:"bytes,78,opt,name=kill_feed,json=killFeed,proto3,oneof"` // Server
+}
+
+type Packet_StatsRequest struct {
+	StatsRequest *StatsRequest `protobuf:"bytes,79,opt,name=stats_request,json=statsRequest,proto3,oneof"` // Client
+}
+
+type Packet_StatsResponse struct {
+	StatsResponse *StatsResponse `protobuf:"bytes,80,opt,name=stats_response,json=statsResponse,proto3,oneof"` // Server
+}
+
+type Packet_LeaderboardRequest struct {
+	// Leaderboards
+	LeaderboardRequest *LeaderboardRequest `protobuf:"bytes,81,opt,name=leaderboard_request,json=leaderboardRequest,proto3,oneof"` // Client
+}
+
+type Packet_LeaderboardResponse struct {
+	LeaderboardResponse *LeaderboardResponse `protobuf:"bytes,82,opt,name=leaderboard_response,json=leaderboardResponse,proto3,oneof"` // Server
+}
+
+type Packet_RespawnOptions struct {
+	// Respawn
+	RespawnOptions *RespawnOptions `protobuf:"bytes,83,opt,name=respawn_options,json=respawnOptions,proto3,oneof"` // Server
+}
+
+type Packet_RespawnOptionsRequest struct {
+	RespawnOptionsRequest *RespawnOptionsRequest `protobuf:"bytes,84,opt,name=respawn_options_request,json=respawnOptionsRequest,proto3,oneof"` // Client
+}
+
+type Packet_SpawnProtection struct {
+	SpawnProtection *SpawnProtection `protobuf:"bytes,85,opt,name=spawn_protection,json=spawnProtection,proto3,oneof"` // Server
+}
+
+type Packet_Announcement struct {
+	// Administration
+	Announcement *Announcement `protobuf:"bytes,86,opt,name=announcement,proto3,oneof"` // Server
+}
+
+type Packet_Kicked struct {
+	Kicked *Kicked `protobuf:"bytes,87,opt,name=kicked,proto3,oneof"` // Server
+}
+
 func (*Packet_PublicMessage) isPacket_Payload() {}
 
 func (*Packet_Handshake) isPacket_Payload() {}
@@ -2387,6 +6874,192 @@ func (*Packet_RespawnRequest) isPacket_Payload() {}
 
 func (*Packet_CrouchCharacter) isPacket_Payload() {}
 
+func (*Packet_FriendRequest) isPacket_Payload() {}
+
+func (*Packet_FriendAccept) isPacket_Payload() {}
+
+func (*Packet_FriendRemove) isPacket_Payload() {}
+
+func (*Packet_FriendsList) isPacket_Payload() {}
+
+func (*Packet_FriendStatus) isPacket_Payload() {}
+
+func (*Packet_GuildCreate) isPacket_Payload() {}
+
+func (*Packet_GuildDisband) isPacket_Payload() {}
+
+func (*Packet_GuildInvite) isPacket_Payload() {}
+
+func (*Packet_GuildInviteResponse) isPacket_Payload() {}
+
+func (*Packet_GuildLeave) isPacket_Payload() {}
+
+func (*Packet_GuildKick) isPacket_Payload() {}
+
+func (*Packet_GuildSetRank) isPacket_Payload() {}
+
+func (*Packet_GuildEditRank) isPacket_Payload() {}
+
+func (*Packet_GuildRosterRequest) isPacket_Payload() {}
+
+func (*Packet_GuildRoster) isPacket_Payload() {}
+
+func (*Packet_GuildMessage) isPacket_Payload() {}
+
+func (*Packet_UpdateGuildTag) isPacket_Payload() {}
+
+func (*Packet_InventorySnapshot) isPacket_Payload() {}
+
+func (*Packet_InventoryMove) isPacket_Payload() {}
+
+func (*Packet_InventoryUse) isPacket_Payload() {}
+
+func (*Packet_InventoryDrop) isPacket_Payload() {}
+
+func (*Packet_InventoryEquip) isPacket_Payload() {}
+
+func (*Packet_InventoryUnequip) isPacket_Payload() {}
+
+func (*Packet_UpdateWeaponSlot) isPacket_Payload() {}
+
+func (*Packet_SpawnGroundItem) isPacket_Payload() {}
+
+func (*Packet_DespawnGroundItem) isPacket_Payload() {}
+
+func (*Packet_PickupGroundItem) isPacket_Payload() {}
+
+func (*Packet_ItemUseStarted) isPacket_Payload() {}
+
+func (*Packet_ItemUseEnded) isPacket_Payload() {}
+
+func (*Packet_CancelItemUse) isPacket_Payload() {}
+
+func (*Packet_HealPlayer) isPacket_Payload() {}
+
+func (*Packet_ArmorSnapshot) isPacket_Payload() {}
+
+func (*Packet_ArmorEquip) isPacket_Payload() {}
+
+func (*Packet_ArmorUnequip) isPacket_Payload() {}
+
+func (*Packet_StatusEffectApplied) isPacket_Payload() {}
+
+func (*Packet_StatusEffectExpired) isPacket_Payload() {}
+
+func (*Packet_HazardZones) isPacket_Payload() {}
+
+func (*Packet_SpawnNpc) isPacket_Payload() {}
+
+func (*Packet_MoveNpc) isPacket_Payload() {}
+
+func (*Packet_NpcFire) isPacket_Payload() {}
+
+func (*Packet_NpcDied) isPacket_Payload() {}
+
+func (*Packet_DespawnNpc) isPacket_Payload() {}
+
+func (*Packet_MatchState) isPacket_Payload() {}
+
+func (*Packet_Scoreboard) isPacket_Payload() {}
+
+func (*Packet_KillFeed) isPacket_Payload() {}
+
+func (*Packet_StatsRequest) isPacket_Payload() {}
+
+func (*Packet_StatsResponse) isPacket_Payload() {}
+
+func (*Packet_LeaderboardRequest) isPacket_Payload() {}
+
+func (*Packet_LeaderboardResponse) isPacket_Payload() {}
+
+func (*Packet_RespawnOptions) isPacket_Payload() {}
+
+func (*Packet_RespawnOptionsRequest) isPacket_Payload() {}
+
+func (*Packet_SpawnProtection) isPacket_Payload() {}
+
+func (*Packet_Announcement) isPacket_Payload() {}
+
+func (*Packet_Kicked) isPacket_Payload() {}
+
+// Session recordings, only written to disk by the server when started with -record (never sent over the network)
+type RecordedPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`               // Unix time in nanoseconds
+	ClientId  uint64  `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // Ephemeral ID of the recorded client
+	State     string  `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`                        // Name of the client's state when the packet went through
+	Outbound  bool    `protobuf:"varint,4,opt,name=outbound,proto3" json:"outbound,omitempty"`                 // True if the server sent this packet, false if the client did
+	Packet    *Packet `protobuf:"bytes,5,opt,name=packet,proto3" json:"packet,omitempty"`
+}
+
+func (x *RecordedPacket) Reset() {
+	*x = RecordedPacket{}
+	mi := &file_packets_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordedPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordedPacket) ProtoMessage() {}
+
+func (x *RecordedPacket) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordedPacket.ProtoReflect.Descriptor instead.
+func (*RecordedPacket) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RecordedPacket) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RecordedPacket) GetClientId() uint64 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *RecordedPacket) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RecordedPacket) GetOutbound() bool {
+	if x != nil {
+		return x.Outbound
+	}
+	return false
+}
+
+func (x *RecordedPacket) GetPacket() *Packet {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
 var File_packets_proto protoreflect.FileDescriptor
 
 var file_packets_proto_rawDesc = []byte{
@@ -2445,7 +7118,7 @@ var file_packets_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x72, 0x69, 0x64, 0x57, 0x69, 0x64, 0x74,
 	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x72, 0x69, 0x64, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x22, 0xe0, 0x02, 0x0a, 0x0e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x68, 0x74, 0x22, 0xfd, 0x02, 0x0a, 0x0e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x43, 0x68, 0x61, 0x72,
 	0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73,
@@ -2467,219 +7140,847 @@ var file_packets_proto_rawDesc = []byte{
 	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x07, 0x77, 0x65, 0x61, 0x70, 0x6f,
 	0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x69,
 	0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x72, 0x6f, 0x75,
-	0x63, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x3e, 0x0a, 0x0d, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61,
-	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x0f, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x72, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x77, 0x61, 0x69, 0x74,
-	0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x61, 0x77, 0x61, 0x69, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29,
-	0x0a, 0x0b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a,
-	0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x7a,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x7a, 0x22, 0x23, 0x0a, 0x0b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x29,
-	0x0a, 0x0a, 0x43, 0x68, 0x61, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0xe4, 0x01,
-	0x0a, 0x0a, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
-	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74,
+	0x61, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x54,
+	0x61, 0x67, 0x22, 0x3e, 0x0a, 0x0d, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x0f, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x59, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x77,
+	0x61, 0x69, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x01, 0x7a, 0x22, 0x23, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0a, 0x43,
+	0x68, 0x61, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x0a, 0x57,
+	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6c, 0x6f,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x6c, 0x6f, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x61, 0x70,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x61,
+	0x70, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x61, 0x6d, 0x6d,
+	0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6d,
+	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x41, 0x6d, 0x6d, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x69, 0x72, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x22, 0x6a, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x65, 0x61, 0x70, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x67, 0x61, 0x7a, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61,
+	0x67, 0x61, 0x7a, 0x69, 0x6e, 0x65, 0x41, 0x6d, 0x6d, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x41, 0x6d, 0x6d, 0x6f, 0x22, 0x0d, 0x0a,
+	0x0b, 0x52, 0x61, 0x69, 0x73, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x22, 0x0d, 0x0a, 0x0b,
+	0x4c, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x0a, 0x46,
+	0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x03, 0x68, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x48, 0x69, 0x74, 0x52, 0x03, 0x68, 0x69, 0x74, 0x22, 0x36, 0x0a, 0x12, 0x46, 0x69, 0x72,
+	0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74,
+	0x73, 0x22, 0x10, 0x0a, 0x0e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x69, 0x72, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48,
+	0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x11, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x74, 0x69, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x6d, 0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72,
+	0x50, 0x69, 0x65, 0x63, 0x65, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x22, 0x4a, 0x0a, 0x0a,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x01, 0x7a, 0x22, 0x34, 0x0a, 0x0f, 0x43, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x72, 0x6f,
+	0x75, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73,
+	0x43, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x2b, 0x0a, 0x0d, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x0c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x0c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x62,
+	0x0a, 0x0a, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x67, 0x0a, 0x0b, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x0c, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x33, 0x0a, 0x0b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x44,
+	0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x0b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x2d, 0x0a, 0x13, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22,
+	0x0c, 0x0a, 0x0a, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x22, 0x27, 0x0a,
+	0x09, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x0c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x53,
+	0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x59, 0x0a, 0x0d, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x45,
+	0x64, 0x69, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x55, 0x0a, 0x09, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a, 0x0b, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x28, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x61, 0x6e, 0x6b, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x0c, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x2d, 0x0a, 0x0e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x22, 0xf7, 0x01, 0x0a, 0x0d, 0x49, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x61,
+	0x6d, 0x6d, 0x6f, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x61, 0x0a, 0x0d, 0x49, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x66, 0x72, 0x6f, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x6f, 0x53, 0x6c, 0x6f,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x22, 0x0a,
+	0x0c, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x22, 0x3f, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x72,
+	0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0x45, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45,
+	0x71, 0x75, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x61, 0x70,
+	0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77,
+	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x33, 0x0a, 0x10, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x65, 0x71, 0x75, 0x69, 0x70, 0x12, 0x1f, 0x0a,
+	0x0b, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x3f,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x6c,
+	0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x57, 0x65, 0x61,
+	0x70, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x22,
+	0xa7, 0x01, 0x0a, 0x0a, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x50, 0x69, 0x65, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44,
+	0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x3c, 0x0a, 0x0d, 0x41, 0x72, 0x6d,
+	0x6f, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x70, 0x69,
+	0x65, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x50, 0x69, 0x65, 0x63, 0x65, 0x52,
+	0x06, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x0a, 0x41, 0x72, 0x6d, 0x6f, 0x72,
+	0x45, 0x71, 0x75, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x2d, 0x0a, 0x0c, 0x41, 0x72, 0x6d,
+	0x6f, 0x72, 0x55, 0x6e, 0x65, 0x71, 0x75, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61,
+	0x72, 0x6d, 0x6f, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0xa2, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x73, 0x70, 0x65, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0xc6, 0x01,
+	0x0a, 0x13, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x6a, 0x0a, 0x0a, 0x48, 0x61, 0x7a, 0x61, 0x72, 0x64,
+	0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x7a, 0x61,
+	0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68,
+	0x61, 0x7a, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x22, 0x38, 0x0a, 0x0b, 0x48, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x61, 0x7a, 0x61, 0x72,
+	0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0xac, 0x01, 0x0a,
+	0x0f, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x61, 0x6d, 0x6d, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f,
-	0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x41, 0x6d, 0x6d, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x65, 0x5f,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x69, 0x72, 0x65,
-	0x4d, 0x6f, 0x64, 0x65, 0x22, 0x6a, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x65,
-	0x61, 0x70, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x67, 0x61,
-	0x7a, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x6d, 0x61, 0x67, 0x61, 0x7a, 0x69, 0x6e, 0x65, 0x41, 0x6d, 0x6d, 0x6f, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x41, 0x6d, 0x6d, 0x6f,
-	0x22, 0x0d, 0x0a, 0x0b, 0x52, 0x61, 0x69, 0x73, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x22,
-	0x0d, 0x0a, 0x0b, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x22, 0x2c,
-	0x0a, 0x0a, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x03,
-	0x68, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x74, 0x52, 0x03, 0x68, 0x69, 0x74, 0x22, 0x36, 0x0a, 0x12,
-	0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x74, 0x52, 0x04,
-	0x68, 0x69, 0x74, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x69,
-	0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x68, 0x69, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2e, 0x48, 0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x11,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61,
-	0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63,
-	0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x73, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x4a, 0x0a, 0x0a, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x44, 0x69, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x74,
-	0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x7a,
-	0x22, 0x34, 0x0a, 0x0f, 0x43, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
-	0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x72, 0x6f, 0x75, 0x63, 0x68,
-	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x72, 0x6f,
-	0x75, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x22, 0xbd, 0x10, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3f,
-	0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x32, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x61, 0x6e,
-	0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x48, 0x00, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68,
-	0x61, 0x6b, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65,
-	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0e,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x12, 0x3c, 0x0a,
-	0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x3f, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x65,
-	0x64, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x65, 0x72,
-	0x65, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66,
-	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x0a,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x4c, 0x0a, 0x13, 0x6a, 0x6f,
-	0x69, 0x6e, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x42, 0x0a, 0x0f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
-	0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
-	0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x61,
-	0x63, 0x74, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0e, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x63, 0x68, 0x61,
-	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61,
-	0x63, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x72,
-	0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f,
-	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x6f, 0x74,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65,
-	0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x65,
-	0x64, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x62, 0x75, 0x62, 0x62, 0x6c, 0x65,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x43, 0x68, 0x61, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63,
-	0x68, 0x61, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x6f, 0x61,
-	0x64, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x57,
-	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x57,
-	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x72, 0x61, 0x69, 0x73, 0x65, 0x5f, 0x77,
-	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x61, 0x69, 0x73, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f,
-	0x6e, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x69, 0x73, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e,
-	0x12, 0x39, 0x0a, 0x0c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e,
-	0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b,
-	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x66,
-	0x69, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57,
-	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61,
-	0x70, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x14, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x61, 0x70,
-	0x6f, 0x6e, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x72, 0x65,
-	0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x48, 0x00,
-	0x52, 0x12, 0x66, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x5f, 0x66,
-	0x69, 0x72, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46,
-	0x69, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x46, 0x69, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67,
-	0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61,
-	0x6d, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x61, 0x70,
-	0x70, 0x6c, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67,
-	0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x6d,
-	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x5f, 0x64, 0x69, 0x65, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69,
-	0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x65, 0x64,
-	0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x63, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x5f, 0x63,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x43,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x72, 0x6f, 0x75,
-	0x63, 0x68, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x23, 0x0a, 0x11, 0x44,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x22, 0x0a, 0x10, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x0e, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x61, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x45,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x93, 0x02, 0x0a, 0x08,
+	0x53, 0x70, 0x61, 0x77, 0x6e, 0x4e, 0x70, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x22, 0x8a, 0x01, 0x0a, 0x07, 0x4d, 0x6f, 0x76, 0x65, 0x4e, 0x70, 0x63, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x73, 0x5f, 0x63, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x48,
+	0x0a, 0x07, 0x4e, 0x70, 0x63, 0x46, 0x69, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x68, 0x69, 0x74, 0x22, 0x3a, 0x0a, 0x07, 0x4e, 0x70, 0x63, 0x44,
+	0x69, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x0a, 0x44, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x4e,
+	0x70, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74,
+	0x65, 0x61, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f,
+	0x66, 0x69, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x6c, 0x79, 0x46, 0x69, 0x72, 0x65, 0x22, 0x78, 0x0a, 0x10, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x74, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x65, 0x61, 0x74,
+	0x68, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x22, 0xc7,
+	0x01, 0x0a, 0x08, 0x4b, 0x69, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6d, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
+	0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x2a, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x0b, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x61, 0x70, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x46, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68,
+	0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x22, 0xbf, 0x02, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x70, 0x63, 0x5f, 0x6b, 0x69, 0x6c,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x70, 0x63, 0x4b, 0x69, 0x6c,
+	0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x6b, 0x65, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x46, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x57,
+	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x77, 0x65, 0x61, 0x70,
+	0x6f, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x74,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2d,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x12, 0x35, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x22,
+	0x17, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x0f, 0x53, 0x70, 0x61, 0x77,
+	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x20, 0x0a, 0x06, 0x4b,
+	0x69, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xde, 0x2b,
+	0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68,
+	0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x48, 0x00, 0x52,
+	0x09, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x68, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x3f,
+	0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x00,
+	0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x64,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65,
+	0x6e, 0x69, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c,
+	0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x66,
+	0x74, 0x12, 0x4c, 0x0a, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x6a, 0x6f,
+	0x69, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0e, 0x6d,
+	0x6f, 0x76, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4d, 0x6f,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x0f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a,
+	0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x62, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x42, 0x75, 0x62, 0x62,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x42, 0x75, 0x62, 0x62, 0x6c, 0x65,
+	0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f,
+	0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x0c, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x3c,
+	0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c,
+	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0c,
+	0x72, 0x61, 0x69, 0x73, 0x65, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x61, 0x69,
+	0x73, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x69, 0x73,
+	0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x61,
+	0x70, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x61, 0x70,
+	0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f,
+	0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a,
+	0x66, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x14, 0x66, 0x69,
+	0x72, 0x65, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x12, 0x66, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61,
+	0x70, 0x6f, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x74,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x69, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x0e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x69, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x4f, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x4c, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x61, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x36, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x65, 0x64, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x44, 0x69, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x63,
+	0x72, 0x6f, 0x75, 0x63, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18,
+	0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x43, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x0f, 0x63, 0x72, 0x6f, 0x75, 0x63, 0x68, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48,
+	0x00, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12,
+	0x39, 0x0a, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x26, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x69, 0x73,
+	0x62, 0x61, 0x6e, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x69, 0x73, 0x62, 0x61, 0x6e,
+	0x64, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x69, 0x73, 0x62, 0x61, 0x6e,
+	0x64, 0x12, 0x39, 0x0a, 0x0c, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x0b, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x15,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x0b, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x18,
+	0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x6b, 0x69, 0x63, 0x6b, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x69, 0x63, 0x6b,
+	0x48, 0x00, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x3d, 0x0a,
+	0x0e, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x0c,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x40, 0x0a, 0x0f,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x64, 0x69, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x48, 0x00, 0x52,
+	0x0d, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x64, 0x69, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x4f,
+	0x0a, 0x14, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x0c, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0d, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x32, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x48, 0x00, 0x52, 0x0e, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x12, 0x4b, 0x0a,
+	0x12, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x69, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x34, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x69,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x35, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x69, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x36, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x72, 0x6f, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x42, 0x0a, 0x0f, 0x69, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x70, 0x18, 0x37, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x71, 0x75, 0x69, 0x70, 0x48, 0x00, 0x52, 0x0e,
+	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x71, 0x75, 0x69, 0x70, 0x12, 0x48,
+	0x0a, 0x11, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x65, 0x71,
+	0x75, 0x69, 0x70, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x65,
+	0x71, 0x75, 0x69, 0x70, 0x48, 0x00, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x55, 0x6e, 0x65, 0x71, 0x75, 0x69, 0x70, 0x12, 0x49, 0x0a, 0x12, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x39,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x48,
+	0x00, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x4c, 0x0a, 0x13, 0x64,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x11, 0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x49, 0x0a, 0x12, 0x70, 0x69, 0x63,
+	0x6b, 0x75, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d,
+	0x48, 0x00, 0x52, 0x10, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x43, 0x0a, 0x10, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x55,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0e, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x3e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x55, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d,
+	0x55, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x3f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x68, 0x65,
+	0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x40, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x5f, 0x65, 0x71, 0x75,
+	0x69, 0x70, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x48, 0x00, 0x52,
+	0x0a, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x12, 0x3c, 0x0a, 0x0d, 0x61,
+	0x72, 0x6d, 0x6f, 0x72, 0x5f, 0x75, 0x6e, 0x65, 0x71, 0x75, 0x69, 0x70, 0x18, 0x43, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x72, 0x6d,
+	0x6f, 0x72, 0x55, 0x6e, 0x65, 0x71, 0x75, 0x69, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x55, 0x6e, 0x65, 0x71, 0x75, 0x69, 0x70, 0x12, 0x52, 0x0a, 0x15, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x18, 0x44, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x13, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x52, 0x0a,
+	0x15, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x45, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x13, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x39, 0x0a, 0x0c, 0x68, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x7a, 0x6f, 0x6e, 0x65,
+	0x73, 0x18, 0x46, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x48, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x48, 0x00, 0x52,
+	0x0b, 0x68, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x09,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x6e, 0x70, 0x63, 0x18, 0x47, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x4e,
+	0x70, 0x63, 0x48, 0x00, 0x52, 0x08, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x4e, 0x70, 0x63, 0x12, 0x2d,
+	0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6e, 0x70, 0x63, 0x18, 0x48, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4e,
+	0x70, 0x63, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x70, 0x63, 0x12, 0x2d, 0x0a,
+	0x08, 0x6e, 0x70, 0x63, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x18, 0x49, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4e, 0x70, 0x63, 0x46, 0x69, 0x72,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x70, 0x63, 0x46, 0x69, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x08,
+	0x6e, 0x70, 0x63, 0x5f, 0x64, 0x69, 0x65, 0x64, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4e, 0x70, 0x63, 0x44, 0x69, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x07, 0x6e, 0x70, 0x63, 0x44, 0x69, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x6e, 0x70, 0x63, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x4e, 0x70, 0x63, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x4e, 0x70, 0x63, 0x12, 0x36, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x18,
+	0x4e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x4b, 0x69, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x6b, 0x69, 0x6c, 0x6c,
+	0x46, 0x65, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x4f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x13, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x51, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x12, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x14, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x52, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x17, 0x72, 0x65,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x54, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x72,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x55, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x50, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0c, 0x61,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x56, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x6b, 0x69, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x57, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x69, 0x63,
+	0x6b, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xa6,
+	0x01, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x27,
+	0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2694,89 +7995,226 @@ func file_packets_proto_rawDescGZIP() []byte {
 	return file_packets_proto_rawDescData
 }
 
-var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
 var file_packets_proto_goTypes = []any{
-	(*Position)(nil),           // 0: packets.Position
-	(*Hit)(nil),                // 1: packets.Hit
-	(*PublicMessage)(nil),      // 2: packets.PublicMessage
-	(*Handshake)(nil),          // 3: packets.Handshake
-	(*Heartbeat)(nil),          // 4: packets.Heartbeat
-	(*ServerMetrics)(nil),      // 5: packets.ServerMetrics
-	(*RequestGranted)(nil),     // 6: packets.RequestGranted
-	(*RequestDenied)(nil),      // 7: packets.RequestDenied
-	(*LoginRequest)(nil),       // 8: packets.LoginRequest
-	(*RegisterRequest)(nil),    // 9: packets.RegisterRequest
-	(*LoginSuccess)(nil),       // 10: packets.LoginSuccess
-	(*LogoutRequest)(nil),      // 11: packets.LogoutRequest
-	(*ClientEntered)(nil),      // 12: packets.ClientEntered
-	(*ClientLeft)(nil),         // 13: packets.ClientLeft
-	(*JoinRegionRequest)(nil),  // 14: packets.JoinRegionRequest
-	(*RegionData)(nil),         // 15: packets.RegionData
-	(*SpawnCharacter)(nil),     // 16: packets.SpawnCharacter
-	(*MoveCharacter)(nil),      // 17: packets.MoveCharacter
-	(*RotateCharacter)(nil),    // 18: packets.RotateCharacter
-	(*Destination)(nil),        // 19: packets.Destination
-	(*UpdateSpeed)(nil),        // 20: packets.UpdateSpeed
-	(*ChatBubble)(nil),         // 21: packets.ChatBubble
-	(*SwitchWeapon)(nil),       // 22: packets.SwitchWeapon
-	(*WeaponSlot)(nil),         // 23: packets.WeaponSlot
-	(*ReloadWeapon)(nil),       // 24: packets.ReloadWeapon
-	(*RaiseWeapon)(nil),        // 25: packets.RaiseWeapon
-	(*LowerWeapon)(nil),        // 26: packets.LowerWeapon
-	(*FireWeapon)(nil),         // 27: packets.FireWeapon
-	(*FireWeaponMultiple)(nil), // 28: packets.FireWeaponMultiple
-	(*ToggleFireMode)(nil),     // 29: packets.ToggleFireMode
-	(*ReportPlayerDamage)(nil), // 30: packets.ReportPlayerDamage
-	(*ApplyPlayerDamage)(nil),  // 31: packets.ApplyPlayerDamage
-	(*PlayerDied)(nil),         // 32: packets.PlayerDied
-	(*RespawnRequest)(nil),     // 33: packets.RespawnRequest
-	(*CrouchCharacter)(nil),    // 34: packets.CrouchCharacter
-	(*Packet)(nil),             // 35: packets.Packet
+	(*Position)(nil),              // 0: packets.Position
+	(*Hit)(nil),                   // 1: packets.Hit
+	(*PublicMessage)(nil),         // 2: packets.PublicMessage
+	(*Handshake)(nil),             // 3: packets.Handshake
+	(*Heartbeat)(nil),             // 4: packets.Heartbeat
+	(*ServerMetrics)(nil),         // 5: packets.ServerMetrics
+	(*RequestGranted)(nil),        // 6: packets.RequestGranted
+	(*RequestDenied)(nil),         // 7: packets.RequestDenied
+	(*LoginRequest)(nil),          // 8: packets.LoginRequest
+	(*RegisterRequest)(nil),       // 9: packets.RegisterRequest
+	(*LoginSuccess)(nil),          // 10: packets.LoginSuccess
+	(*LogoutRequest)(nil),         // 11: packets.LogoutRequest
+	(*ClientEntered)(nil),         // 12: packets.ClientEntered
+	(*ClientLeft)(nil),            // 13: packets.ClientLeft
+	(*JoinRegionRequest)(nil),     // 14: packets.JoinRegionRequest
+	(*RegionData)(nil),            // 15: packets.RegionData
+	(*SpawnCharacter)(nil),        // 16: packets.SpawnCharacter
+	(*MoveCharacter)(nil),         // 17: packets.MoveCharacter
+	(*RotateCharacter)(nil),       // 18: packets.RotateCharacter
+	(*Destination)(nil),           // 19: packets.Destination
+	(*UpdateSpeed)(nil),           // 20: packets.UpdateSpeed
+	(*ChatBubble)(nil),            // 21: packets.ChatBubble
+	(*SwitchWeapon)(nil),          // 22: packets.SwitchWeapon
+	(*WeaponSlot)(nil),            // 23: packets.WeaponSlot
+	(*ReloadWeapon)(nil),          // 24: packets.ReloadWeapon
+	(*RaiseWeapon)(nil),           // 25: packets.RaiseWeapon
+	(*LowerWeapon)(nil),           // 26: packets.LowerWeapon
+	(*FireWeapon)(nil),            // 27: packets.FireWeapon
+	(*FireWeaponMultiple)(nil),    // 28: packets.FireWeaponMultiple
+	(*ToggleFireMode)(nil),        // 29: packets.ToggleFireMode
+	(*ReportPlayerDamage)(nil),    // 30: packets.ReportPlayerDamage
+	(*ApplyPlayerDamage)(nil),     // 31: packets.ApplyPlayerDamage
+	(*PlayerDied)(nil),            // 32: packets.PlayerDied
+	(*RespawnRequest)(nil),        // 33: packets.RespawnRequest
+	(*CrouchCharacter)(nil),       // 34: packets.CrouchCharacter
+	(*FriendRequest)(nil),         // 35: packets.FriendRequest
+	(*FriendAccept)(nil),          // 36: packets.FriendAccept
+	(*FriendRemove)(nil),          // 37: packets.FriendRemove
+	(*FriendInfo)(nil),            // 38: packets.FriendInfo
+	(*FriendsList)(nil),           // 39: packets.FriendsList
+	(*FriendStatus)(nil),          // 40: packets.FriendStatus
+	(*GuildCreate)(nil),           // 41: packets.GuildCreate
+	(*GuildDisband)(nil),          // 42: packets.GuildDisband
+	(*GuildInvite)(nil),           // 43: packets.GuildInvite
+	(*GuildInviteResponse)(nil),   // 44: packets.GuildInviteResponse
+	(*GuildLeave)(nil),            // 45: packets.GuildLeave
+	(*GuildKick)(nil),             // 46: packets.GuildKick
+	(*GuildSetRank)(nil),          // 47: packets.GuildSetRank
+	(*GuildEditRank)(nil),         // 48: packets.GuildEditRank
+	(*GuildRank)(nil),             // 49: packets.GuildRank
+	(*GuildMember)(nil),           // 50: packets.GuildMember
+	(*GuildRosterRequest)(nil),    // 51: packets.GuildRosterRequest
+	(*GuildRoster)(nil),           // 52: packets.GuildRoster
+	(*GuildMessage)(nil),          // 53: packets.GuildMessage
+	(*UpdateGuildTag)(nil),        // 54: packets.UpdateGuildTag
+	(*InventoryItem)(nil),         // 55: packets.InventoryItem
+	(*InventorySnapshot)(nil),     // 56: packets.InventorySnapshot
+	(*InventoryMove)(nil),         // 57: packets.InventoryMove
+	(*InventoryUse)(nil),          // 58: packets.InventoryUse
+	(*InventoryDrop)(nil),         // 59: packets.InventoryDrop
+	(*InventoryEquip)(nil),        // 60: packets.InventoryEquip
+	(*InventoryUnequip)(nil),      // 61: packets.InventoryUnequip
+	(*UpdateWeaponSlot)(nil),      // 62: packets.UpdateWeaponSlot
+	(*ArmorPiece)(nil),            // 63: packets.ArmorPiece
+	(*ArmorSnapshot)(nil),         // 64: packets.ArmorSnapshot
+	(*ArmorEquip)(nil),            // 65: packets.ArmorEquip
+	(*ArmorUnequip)(nil),          // 66: packets.ArmorUnequip
+	(*StatusEffectApplied)(nil),   // 67: packets.StatusEffectApplied
+	(*StatusEffectExpired)(nil),   // 68: packets.StatusEffectExpired
+	(*HazardZone)(nil),            // 69: packets.HazardZone
+	(*HazardZones)(nil),           // 70: packets.HazardZones
+	(*SpawnGroundItem)(nil),       // 71: packets.SpawnGroundItem
+	(*DespawnGroundItem)(nil),     // 72: packets.DespawnGroundItem
+	(*PickupGroundItem)(nil),      // 73: packets.PickupGroundItem
+	(*ItemUseStarted)(nil),        // 74: packets.ItemUseStarted
+	(*ItemUseEnded)(nil),          // 75: packets.ItemUseEnded
+	(*CancelItemUse)(nil),         // 76: packets.CancelItemUse
+	(*HealPlayer)(nil),            // 77: packets.HealPlayer
+	(*SpawnNpc)(nil),              // 78: packets.SpawnNpc
+	(*MoveNpc)(nil),               // 79: packets.MoveNpc
+	(*NpcFire)(nil),               // 80: packets.NpcFire
+	(*NpcDied)(nil),               // 81: packets.NpcDied
+	(*DespawnNpc)(nil),            // 82: packets.DespawnNpc
+	(*MatchState)(nil),            // 83: packets.MatchState
+	(*MatchPlayerScore)(nil),      // 84: packets.MatchPlayerScore
+	(*Scoreboard)(nil),            // 85: packets.Scoreboard
+	(*KillFeed)(nil),              // 86: packets.KillFeed
+	(*StatsRequest)(nil),          // 87: packets.StatsRequest
+	(*WeaponStats)(nil),           // 88: packets.WeaponStats
+	(*StatsResponse)(nil),         // 89: packets.StatsResponse
+	(*LeaderboardRequest)(nil),    // 90: packets.LeaderboardRequest
+	(*LeaderboardEntry)(nil),      // 91: packets.LeaderboardEntry
+	(*LeaderboardResponse)(nil),   // 92: packets.LeaderboardResponse
+	(*RespawnPoint)(nil),          // 93: packets.RespawnPoint
+	(*RespawnOptions)(nil),        // 94: packets.RespawnOptions
+	(*RespawnOptionsRequest)(nil), // 95: packets.RespawnOptionsRequest
+	(*SpawnProtection)(nil),       // 96: packets.SpawnProtection
+	(*Announcement)(nil),          // 97: packets.Announcement
+	(*Kicked)(nil),                // 98: packets.Kicked
+	(*Packet)(nil),                // 99: packets.Packet
+	(*RecordedPacket)(nil),        // 100: packets.RecordedPacket
 }
 var file_packets_proto_depIdxs = []int32{
-	0,  // 0: packets.SpawnCharacter.position:type_name -> packets.Position
-	23, // 1: packets.SpawnCharacter.weapons:type_name -> packets.WeaponSlot
-	0,  // 2: packets.MoveCharacter.position:type_name -> packets.Position
-	1,  // 3: packets.FireWeapon.hit:type_name -> packets.Hit
-	1,  // 4: packets.FireWeaponMultiple.hits:type_name -> packets.Hit
-	1,  // 5: packets.ReportPlayerDamage.hits:type_name -> packets.Hit
-	2,  // 6: packets.Packet.public_message:type_name -> packets.PublicMessage
-	3,  // 7: packets.Packet.handshake:type_name -> packets.Handshake
-	4,  // 8: packets.Packet.heartbeat:type_name -> packets.Heartbeat
-	5,  // 9: packets.Packet.server_metrics:type_name -> packets.ServerMetrics
-	6,  // 10: packets.Packet.request_granted:type_name -> packets.RequestGranted
-	7,  // 11: packets.Packet.request_denied:type_name -> packets.RequestDenied
-	8,  // 12: packets.Packet.login_request:type_name -> packets.LoginRequest
-	9,  // 13: packets.Packet.register_request:type_name -> packets.RegisterRequest
-	10, // 14: packets.Packet.login_success:type_name -> packets.LoginSuccess
-	11, // 15: packets.Packet.logout_request:type_name -> packets.LogoutRequest
-	12, // 16: packets.Packet.client_entered:type_name -> packets.ClientEntered
-	13, // 17: packets.Packet.client_left:type_name -> packets.ClientLeft
-	14, // 18: packets.Packet.join_region_request:type_name -> packets.JoinRegionRequest
-	15, // 19: packets.Packet.region_data:type_name -> packets.RegionData
-	16, // 20: packets.Packet.spawn_character:type_name -> packets.SpawnCharacter
-	17, // 21: packets.Packet.move_character:type_name -> packets.MoveCharacter
-	18, // 22: packets.Packet.rotate_character:type_name -> packets.RotateCharacter
-	19, // 23: packets.Packet.destination:type_name -> packets.Destination
-	20, // 24: packets.Packet.update_speed:type_name -> packets.UpdateSpeed
-	21, // 25: packets.Packet.chat_bubble:type_name -> packets.ChatBubble
-	22, // 26: packets.Packet.switch_weapon:type_name -> packets.SwitchWeapon
-	24, // 27: packets.Packet.reload_weapon:type_name -> packets.ReloadWeapon
-	25, // 28: packets.Packet.raise_weapon:type_name -> packets.RaiseWeapon
-	26, // 29: packets.Packet.lower_weapon:type_name -> packets.LowerWeapon
-	27, // 30: packets.Packet.fire_weapon:type_name -> packets.FireWeapon
-	28, // 31: packets.Packet.fire_weapon_multiple:type_name -> packets.FireWeaponMultiple
-	29, // 32: packets.Packet.toggle_fire_mode:type_name -> packets.ToggleFireMode
-	30, // 33: packets.Packet.report_player_damage:type_name -> packets.ReportPlayerDamage
-	31, // 34: packets.Packet.apply_player_damage:type_name -> packets.ApplyPlayerDamage
-	32, // 35: packets.Packet.player_died:type_name -> packets.PlayerDied
-	33, // 36: packets.Packet.respawn_request:type_name -> packets.RespawnRequest
-	34, // 37: packets.Packet.crouch_character:type_name -> packets.CrouchCharacter
-	38, // [38:38] is the sub-list for method output_type
-	38, // [38:38] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+	0,   // 0: packets.SpawnCharacter.position:type_name -> packets.Position
+	23,  // 1: packets.SpawnCharacter.weapons:type_name -> packets.WeaponSlot
+	0,   // 2: packets.MoveCharacter.position:type_name -> packets.Position
+	1,   // 3: packets.FireWeapon.hit:type_name -> packets.Hit
+	1,   // 4: packets.FireWeaponMultiple.hits:type_name -> packets.Hit
+	1,   // 5: packets.ReportPlayerDamage.hits:type_name -> packets.Hit
+	63,  // 6: packets.ApplyPlayerDamage.armor:type_name -> packets.ArmorPiece
+	38,  // 7: packets.FriendsList.friends:type_name -> packets.FriendInfo
+	49,  // 8: packets.GuildRoster.ranks:type_name -> packets.GuildRank
+	50,  // 9: packets.GuildRoster.members:type_name -> packets.GuildMember
+	55,  // 10: packets.InventorySnapshot.items:type_name -> packets.InventoryItem
+	23,  // 11: packets.UpdateWeaponSlot.weapon:type_name -> packets.WeaponSlot
+	63,  // 12: packets.ArmorSnapshot.pieces:type_name -> packets.ArmorPiece
+	0,   // 13: packets.HazardZone.cells:type_name -> packets.Position
+	69,  // 14: packets.HazardZones.zones:type_name -> packets.HazardZone
+	0,   // 15: packets.SpawnGroundItem.position:type_name -> packets.Position
+	0,   // 16: packets.SpawnNpc.position:type_name -> packets.Position
+	0,   // 17: packets.MoveNpc.position:type_name -> packets.Position
+	84,  // 18: packets.Scoreboard.players:type_name -> packets.MatchPlayerScore
+	88,  // 19: packets.StatsResponse.weapons:type_name -> packets.WeaponStats
+	91,  // 20: packets.LeaderboardResponse.entries:type_name -> packets.LeaderboardEntry
+	0,   // 21: packets.RespawnPoint.position:type_name -> packets.Position
+	93,  // 22: packets.RespawnOptions.respawners:type_name -> packets.RespawnPoint
+	2,   // 23: packets.Packet.public_message:type_name -> packets.PublicMessage
+	3,   // 24: packets.Packet.handshake:type_name -> packets.Handshake
+	4,   // 25: packets.Packet.heartbeat:type_name -> packets.Heartbeat
+	5,   // 26: packets.Packet.server_metrics:type_name -> packets.ServerMetrics
+	6,   // 27: packets.Packet.request_granted:type_name -> packets.RequestGranted
+	7,   // 28: packets.Packet.request_denied:type_name -> packets.RequestDenied
+	8,   // 29: packets.Packet.login_request:type_name -> packets.LoginRequest
+	9,   // 30: packets.Packet.register_request:type_name -> packets.RegisterRequest
+	10,  // 31: packets.Packet.login_success:type_name -> packets.LoginSuccess
+	11,  // 32: packets.Packet.logout_request:type_name -> packets.LogoutRequest
+	12,  // 33: packets.Packet.client_entered:type_name -> packets.ClientEntered
+	13,  // 34: packets.Packet.client_left:type_name -> packets.ClientLeft
+	14,  // 35: packets.Packet.join_region_request:type_name -> packets.JoinRegionRequest
+	15,  // 36: packets.Packet.region_data:type_name -> packets.RegionData
+	16,  // 37: packets.Packet.spawn_character:type_name -> packets.SpawnCharacter
+	17,  // 38: packets.Packet.move_character:type_name -> packets.MoveCharacter
+	18,  // 39: packets.Packet.rotate_character:type_name -> packets.RotateCharacter
+	19,  // 40: packets.Packet.destination:type_name -> packets.Destination
+	20,  // 41: packets.Packet.update_speed:type_name -> packets.UpdateSpeed
+	21,  // 42: packets.Packet.chat_bubble:type_name -> packets.ChatBubble
+	22,  // 43: packets.Packet.switch_weapon:type_name -> packets.SwitchWeapon
+	24,  // 44: packets.Packet.reload_weapon:type_name -> packets.ReloadWeapon
+	25,  // 45: packets.Packet.raise_weapon:type_name -> packets.RaiseWeapon
+	26,  // 46: packets.Packet.lower_weapon:type_name -> packets.LowerWeapon
+	27,  // 47: packets.Packet.fire_weapon:type_name -> packets.FireWeapon
+	28,  // 48: packets.Packet.fire_weapon_multiple:type_name -> packets.FireWeaponMultiple
+	29,  // 49: packets.Packet.toggle_fire_mode:type_name -> packets.ToggleFireMode
+	30,  // 50: packets.Packet.report_player_damage:type_name -> packets.ReportPlayerDamage
+	31,  // 51: packets.Packet.apply_player_damage:type_name -> packets.ApplyPlayerDamage
+	32,  // 52: packets.Packet.player_died:type_name -> packets.PlayerDied
+	33,  // 53: packets.Packet.respawn_request:type_name -> packets.RespawnRequest
+	34,  // 54: packets.Packet.crouch_character:type_name -> packets.CrouchCharacter
+	35,  // 55: packets.Packet.friend_request:type_name -> packets.FriendRequest
+	36,  // 56: packets.Packet.friend_accept:type_name -> packets.FriendAccept
+	37,  // 57: packets.Packet.friend_remove:type_name -> packets.FriendRemove
+	39,  // 58: packets.Packet.friends_list:type_name -> packets.FriendsList
+	40,  // 59: packets.Packet.friend_status:type_name -> packets.FriendStatus
+	41,  // 60: packets.Packet.guild_create:type_name -> packets.GuildCreate
+	42,  // 61: packets.Packet.guild_disband:type_name -> packets.GuildDisband
+	43,  // 62: packets.Packet.guild_invite:type_name -> packets.GuildInvite
+	44,  // 63: packets.Packet.guild_invite_response:type_name -> packets.GuildInviteResponse
+	45,  // 64: packets.Packet.guild_leave:type_name -> packets.GuildLeave
+	46,  // 65: packets.Packet.guild_kick:type_name -> packets.GuildKick
+	47,  // 66: packets.Packet.guild_set_rank:type_name -> packets.GuildSetRank
+	48,  // 67: packets.Packet.guild_edit_rank:type_name -> packets.GuildEditRank
+	51,  // 68: packets.Packet.guild_roster_request:type_name -> packets.GuildRosterRequest
+	52,  // 69: packets.Packet.guild_roster:type_name -> packets.GuildRoster
+	53,  // 70: packets.Packet.guild_message:type_name -> packets.GuildMessage
+	54,  // 71: packets.Packet.update_guild_tag:type_name -> packets.UpdateGuildTag
+	56,  // 72: packets.Packet.inventory_snapshot:type_name -> packets.InventorySnapshot
+	57,  // 73: packets.Packet.inventory_move:type_name -> packets.InventoryMove
+	58,  // 74: packets.Packet.inventory_use:type_name -> packets.InventoryUse
+	59,  // 75: packets.Packet.inventory_drop:type_name -> packets.InventoryDrop
+	60,  // 76: packets.Packet.inventory_equip:type_name -> packets.InventoryEquip
+	61,  // 77: packets.Packet.inventory_unequip:type_name -> packets.InventoryUnequip
+	62,  // 78: packets.Packet.update_weapon_slot:type_name -> packets.UpdateWeaponSlot
+	71,  // 79: packets.Packet.spawn_ground_item:type_name -> packets.SpawnGroundItem
+	72,  // 80: packets.Packet.despawn_ground_item:type_name -> packets.DespawnGroundItem
+	73,  // 81: packets.Packet.pickup_ground_item:type_name -> packets.PickupGroundItem
+	74,  // 82: packets.Packet.item_use_started:type_name -> packets.ItemUseStarted
+	75,  // 83: packets.Packet.item_use_ended:type_name -> packets.ItemUseEnded
+	76,  // 84: packets.Packet.cancel_item_use:type_name -> packets.CancelItemUse
+	77,  // 85: packets.Packet.heal_player:type_name -> packets.HealPlayer
+	64,  // 86: packets.Packet.armor_snapshot:type_name -> packets.ArmorSnapshot
+	65,  // 87: packets.Packet.armor_equip:type_name -> packets.ArmorEquip
+	66,  // 88: packets.Packet.armor_unequip:type_name -> packets.ArmorUnequip
+	67,  // 89: packets.Packet.status_effect_applied:type_name -> packets.StatusEffectApplied
+	68,  // 90: packets.Packet.status_effect_expired:type_name -> packets.StatusEffectExpired
+	70,  // 91: packets.Packet.hazard_zones:type_name -> packets.HazardZones
+	78,  // 92: packets.Packet.spawn_npc:type_name -> packets.SpawnNpc
+	79,  // 93: packets.Packet.move_npc:type_name -> packets.MoveNpc
+	80,  // 94: packets.Packet.npc_fire:type_name -> packets.NpcFire
+	81,  // 95: packets.Packet.npc_died:type_name -> packets.NpcDied
+	82,  // 96: packets.Packet.despawn_npc:type_name -> packets.DespawnNpc
+	83,  // 97: packets.Packet.match_state:type_name -> packets.MatchState
+	85,  // 98: packets.Packet.scoreboard:type_name -> packets.Scoreboard
+	86,  // 99: packets.Packet.kill_feed:type_name -> packets.KillFeed
+	87,  // 100: packets.Packet.stats_request:type_name -> packets.StatsRequest
+	89,  // 101: packets.Packet.stats_response:type_name -> packets.StatsResponse
+	90,  // 102: packets.Packet.leaderboard_request:type_name -> packets.LeaderboardRequest
+	92,  // 103: packets.Packet.leaderboard_response:type_name -> packets.LeaderboardResponse
+	94,  // 104: packets.Packet.respawn_options:type_name -> packets.RespawnOptions
+	95,  // 105: packets.Packet.respawn_options_request:type_name -> packets.RespawnOptionsRequest
+	96,  // 106: packets.Packet.spawn_protection:type_name -> packets.SpawnProtection
+	97,  // 107: packets.Packet.announcement:type_name -> packets.Announcement
+	98,  // 108: packets.Packet.kicked:type_name -> packets.Kicked
+	99,  // 109: packets.RecordedPacket.packet:type_name -> packets.Packet
+	110, // [110:110] is the sub-list for method output_type
+	110, // [110:110] is the sub-list for method input_type
+	110, // [110:110] is the sub-list for extension type_name
+	110, // [110:110] is the sub-list for extension extendee
+	0,   // [0:110] is the sub-list for field type_name
 }
 
 func init() { file_packets_proto_init() }
@@ -2784,7 +8222,7 @@ func file_packets_proto_init() {
 	if File_packets_proto != nil {
 		return
 	}
-	file_packets_proto_msgTypes[35].OneofWrappers = []any{
+	file_packets_proto_msgTypes[99].OneofWrappers = []any{
 		(*Packet_PublicMessage)(nil),
 		(*Packet_Handshake)(nil),
 		(*Packet_Heartbeat)(nil),
@@ -2817,6 +8255,60 @@ func file_packets_proto_init() {
 		(*Packet_PlayerDied)(nil),
 		(*Packet_RespawnRequest)(nil),
 		(*Packet_CrouchCharacter)(nil),
+		(*Packet_FriendRequest)(nil),
+		(*Packet_FriendAccept)(nil),
+		(*Packet_FriendRemove)(nil),
+		(*Packet_FriendsList)(nil),
+		(*Packet_FriendStatus)(nil),
+		(*Packet_GuildCreate)(nil),
+		(*Packet_GuildDisband)(nil),
+		(*Packet_GuildInvite)(nil),
+		(*Packet_GuildInviteResponse)(nil),
+		(*Packet_GuildLeave)(nil),
+		(*Packet_GuildKick)(nil),
+		(*Packet_GuildSetRank)(nil),
+		(*Packet_GuildEditRank)(nil),
+		(*Packet_GuildRosterRequest)(nil),
+		(*Packet_GuildRoster)(nil),
+		(*Packet_GuildMessage)(nil),
+		(*Packet_UpdateGuildTag)(nil),
+		(*Packet_InventorySnapshot)(nil),
+		(*Packet_InventoryMove)(nil),
+		(*Packet_InventoryUse)(nil),
+		(*Packet_InventoryDrop)(nil),
+		(*Packet_InventoryEquip)(nil),
+		(*Packet_InventoryUnequip)(nil),
+		(*Packet_UpdateWeaponSlot)(nil),
+		(*Packet_SpawnGroundItem)(nil),
+		(*Packet_DespawnGroundItem)(nil),
+		(*Packet_PickupGroundItem)(nil),
+		(*Packet_ItemUseStarted)(nil),
+		(*Packet_ItemUseEnded)(nil),
+		(*Packet_CancelItemUse)(nil),
+		(*Packet_HealPlayer)(nil),
+		(*Packet_ArmorSnapshot)(nil),
+		(*Packet_ArmorEquip)(nil),
+		(*Packet_ArmorUnequip)(nil),
+		(*Packet_StatusEffectApplied)(nil),
+		(*Packet_StatusEffectExpired)(nil),
+		(*Packet_HazardZones)(nil),
+		(*Packet_SpawnNpc)(nil),
+		(*Packet_MoveNpc)(nil),
+		(*Packet_NpcFire)(nil),
+		(*Packet_NpcDied)(nil),
+		(*Packet_DespawnNpc)(nil),
+		(*Packet_MatchState)(nil),
+		(*Packet_Scoreboard)(nil),
+		(*Packet_KillFeed)(nil),
+		(*Packet_StatsRequest)(nil),
+		(*Packet_StatsResponse)(nil),
+		(*Packet_LeaderboardRequest)(nil),
+		(*Packet_LeaderboardResponse)(nil),
+		(*Packet_RespawnOptions)(nil),
+		(*Packet_RespawnOptionsRequest)(nil),
+		(*Packet_SpawnProtection)(nil),
+		(*Packet_Announcement)(nil),
+		(*Packet_Kicked)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2824,7 +8316,7 @@ func file_packets_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_packets_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   36,
+			NumMessages:   101,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,12 +1,37 @@
 package packets
 
 import (
+	"fmt"
+	"math"
 	"server/internal/server/objects"
+	"server/internal/server/pathfinding"
+	"sort"
+	"strings"
+	"time"
 )
 
 // The Packet Struct contains a Payload as an interface called isPacket_Payload
 type Payload = isPacket_Payload
 
+// Short name of the payload type, e.g. "SpawnCharacter", used for logs and metrics
+func TypeName(payload Payload) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", payload), "*packets.Packet_")
+}
+
+// Every payload type name as returned by TypeName, in the order of the schema
+func TypeNames() []string {
+	names := []string{}
+	packet := &Packet{}
+	message := packet.ProtoReflect()
+	fields := message.Descriptor().Oneofs().ByName("payload").Fields()
+	for i := range fields.Len() {
+		// Setting a field of the oneof fills in the payload with its wrapper type
+		message.Set(fields.Get(i), message.NewField(fields.Get(i)))
+		names = append(names, TypeName(packet.Payload))
+	}
+	return names
+}
+
 // Sent by client to communicate with other clients in the area
 func NewPublicMessage(nickname string, text string) Payload {
 	return &Packet_PublicMessage{
@@ -135,6 +160,7 @@ func NewSpawnCharacter(id uint64, player *objects.Player) Payload {
 			CurrentWeapon: player.GetCurrentWeapon(),
 			Weapons:       convertWeaponsToProto(*player.GetWeapons()),
 			IsCrouching:   player.IsCrouching(),
+			GuildTag:      player.GetGuildTag(),
 		},
 	}
 }
@@ -241,14 +267,16 @@ func NewToggleFireMode() Payload {
 }
 
 // Sent by the server to report a player got damaged
-func NewApplyPlayerDamage(attackerId, targetId, damage uint64, damageType string, isCritical bool) Payload {
+func NewApplyPlayerDamage(attackerId, targetId, damage uint64, damageType string, isCritical bool, mitigatedDamage uint64, armor []*ArmorPiece) Payload {
 	return &Packet_ApplyPlayerDamage{
 		ApplyPlayerDamage: &ApplyPlayerDamage{
-			AttackerId: attackerId,
-			TargetId:   targetId,
-			Damage:     damage,
-			DamageType: damageType,
-			IsCritical: isCritical,
+			AttackerId:      attackerId,
+			TargetId:        targetId,
+			Damage:          damage,
+			DamageType:      damageType,
+			IsCritical:      isCritical,
+			MitigatedDamage: mitigatedDamage,
+			Armor:           armor,
 		},
 	}
 }
@@ -271,3 +299,528 @@ func NewCrouchCharacter(isCrouching bool) Payload {
 		},
 	}
 }
+
+// Sent by the client to add a friend and forwarded by the server to the receiver
+func NewFriendRequest(nickname string) Payload {
+	return &Packet_FriendRequest{
+		FriendRequest: &FriendRequest{
+			Nickname: nickname,
+		},
+	}
+}
+
+// Sent by the server with every friend and pending request of this character
+func NewFriendsList(friends []*FriendInfo, pendingRequests []string) Payload {
+	return &Packet_FriendsList{
+		FriendsList: &FriendsList{
+			Friends:         friends,
+			PendingRequests: pendingRequests,
+		},
+	}
+}
+
+// Sent by the server when a friend logs in, logs out or changes region
+func NewFriendStatus(nickname string, isOnline bool, regionId uint64) Payload {
+	return &Packet_FriendStatus{
+		FriendStatus: &FriendStatus{
+			Nickname: nickname,
+			IsOnline: isOnline,
+			RegionId: regionId,
+		},
+	}
+}
+
+// Sent by the server to the invited character with the inviter's nickname
+func NewGuildInvite(nickname string, guildName string) Payload {
+	return &Packet_GuildInvite{
+		GuildInvite: &GuildInvite{
+			Nickname:  nickname,
+			GuildName: guildName,
+		},
+	}
+}
+
+// Sent by the server with the ranks and members of a guild (nil guild means no guild)
+func NewGuildRoster(guild *objects.Guild, isOnline func(characterId int64) bool) Payload {
+	roster := &GuildRoster{}
+
+	if guild != nil {
+		roster.Name = guild.Name
+		roster.Tag = guild.Tag
+
+		for rankIndex, rank := range guild.Ranks {
+			roster.Ranks = append(roster.Ranks, &GuildRank{
+				Rank:        uint64(rankIndex),
+				Name:        rank.Name,
+				Permissions: rank.Permissions,
+			})
+		}
+
+		for characterId, member := range guild.Members {
+			roster.Members = append(roster.Members, &GuildMember{
+				Nickname: member.Nickname,
+				Rank:     member.Rank,
+				IsOnline: isOnline(characterId),
+			})
+		}
+	}
+
+	return &Packet_GuildRoster{
+		GuildRoster: roster,
+	}
+}
+
+// Sent by the server to every guild member that is online
+func NewGuildMessage(nickname string, text string) Payload {
+	return &Packet_GuildMessage{
+		GuildMessage: &GuildMessage{
+			Nickname: nickname,
+			Text:     text,
+		},
+	}
+}
+
+// Broadcast by the server when a character joins or leaves a guild
+func NewUpdateGuildTag(guildTag string) Payload {
+	return &Packet_UpdateGuildTag{
+		UpdateGuildTag: &UpdateGuildTag{
+			GuildTag: guildTag,
+		},
+	}
+}
+
+// Sent by the server with every occupied inventory slot
+func NewInventorySnapshot(inventory *objects.Inventory) Payload {
+	var items []*InventoryItem
+	for slot, item := range inventory.GetSlots() {
+		if item == nil {
+			continue
+		}
+		stats, _ := objects.GetItemStats(item.ItemName)
+		inventoryItem := &InventoryItem{
+			Slot:        uint64(slot),
+			ItemName:    item.ItemName,
+			DisplayName: stats.DisplayName,
+			ItemType:    stats.Type,
+			Quantity:    item.Quantity,
+		}
+		if stats.Type == objects.ITEM_TYPE_ARMOR {
+			inventoryItem.Durability = objects.GetArmorDurability(item)
+			inventoryItem.MaxDurability = stats.MaxDurability
+		}
+		if stats.Type == objects.ITEM_TYPE_WEAPON {
+			inventoryItem.Ammo = item.Ammo
+		}
+		items = append(items, inventoryItem)
+	}
+
+	return &Packet_InventorySnapshot{
+		InventorySnapshot: &InventorySnapshot{
+			Items:     items,
+			Capacity:  inventory.GetCapacity(),
+			Weight:    inventory.GetWeight(),
+			MaxWeight: inventory.GetMaxWeight(),
+		},
+	}
+}
+
+// Sent by the server when a weapon slot changes outside of combat (equip, unequip, ammo boxes)
+func NewUpdateWeaponSlot(slotIndex uint64, weapon *objects.WeaponSlot) Payload {
+	return &Packet_UpdateWeaponSlot{
+		UpdateWeaponSlot: &UpdateWeaponSlot{
+			Weapon: &WeaponSlot{
+				SlotIndex:   slotIndex,
+				WeaponName:  weapon.WeaponName,
+				WeaponType:  weapon.WeaponType,
+				DisplayName: weapon.DisplayName,
+				Ammo:        weapon.Ammo,
+				ReserveAmmo: weapon.ReserveAmmo,
+				FireMode:    weapon.FireMode,
+			},
+		},
+	}
+}
+
+// Sent by the server when an item appears on the ground or its quantity changes
+func NewSpawnGroundItem(item *objects.GroundItem) Payload {
+	stats, _ := objects.GetItemStats(item.ItemName)
+	return &Packet_SpawnGroundItem{
+		SpawnGroundItem: &SpawnGroundItem{
+			Id:          item.Id,
+			ItemName:    item.ItemName,
+			DisplayName: stats.DisplayName,
+			Quantity:    item.Quantity,
+			Position: &Position{
+				X: item.Position.X,
+				Z: item.Position.Z,
+			},
+		},
+	}
+}
+
+// Sent by the server when an item is removed from the ground
+func NewDespawnGroundItem(id uint64) Payload {
+	return &Packet_DespawnGroundItem{
+		DespawnGroundItem: &DespawnGroundItem{
+			Id: id,
+		},
+	}
+}
+
+// Sent by the server when a character starts using an item
+func NewItemUseStarted(itemName string, duration time.Duration) Payload {
+	return &Packet_ItemUseStarted{
+		ItemUseStarted: &ItemUseStarted{
+			ItemName:   itemName,
+			DurationMs: uint64(duration.Milliseconds()),
+		},
+	}
+}
+
+// Sent by the server when a character finishes or stops using an item
+func NewItemUseEnded(itemName string, completed bool, reason string) Payload {
+	return &Packet_ItemUseEnded{
+		ItemUseEnded: &ItemUseEnded{
+			ItemName:  itemName,
+			Completed: completed,
+			Reason:    reason,
+		},
+	}
+}
+
+// Sent by the server when a character recovers health
+func NewHealPlayer(targetId, amount, health uint64, source string) Payload {
+	return &Packet_HealPlayer{
+		HealPlayer: &HealPlayer{
+			TargetId: targetId,
+			Amount:   amount,
+			Health:   health,
+			Source:   source,
+		},
+	}
+}
+
+// Converts an equipped armor piece to its protobuf representation
+func NewArmorPiece(slot uint64, piece *objects.InventoryItem) *ArmorPiece {
+	stats, _ := objects.GetItemStats(piece.ItemName)
+	return &ArmorPiece{
+		Slot:          slot,
+		ItemName:      piece.ItemName,
+		DisplayName:   stats.DisplayName,
+		Durability:    objects.GetArmorDurability(piece),
+		MaxDurability: stats.MaxDurability,
+	}
+}
+
+// Sent by the server with every armor piece our character is wearing
+func NewArmorSnapshot(player *objects.Player) Payload {
+	var pieces []*ArmorPiece
+	for slot := range objects.MAX_ARMOR_SLOTS {
+		if piece := player.GetArmor(slot); piece != nil {
+			pieces = append(pieces, NewArmorPiece(slot, piece))
+		}
+	}
+
+	return &Packet_ArmorSnapshot{
+		ArmorSnapshot: &ArmorSnapshot{
+			Pieces: pieces,
+		},
+	}
+}
+
+// Sent by the server when a character gains a status effect or it stacks
+func NewStatusEffectApplied(targetId uint64, player *objects.Player, effect *objects.StatusEffect) Payload {
+	stats, _ := objects.GetStatusEffectStats(effect.Name)
+	return &Packet_StatusEffectApplied{
+		StatusEffectApplied: &StatusEffectApplied{
+			TargetId:         targetId,
+			EffectName:       effect.Name,
+			DisplayName:      stats.DisplayName,
+			Stacks:           effect.Stacks,
+			DurationMs:       uint64(time.Until(effect.ExpiresAt).Milliseconds()),
+			SpeedModifier:    player.GetSpeedModifier(),
+			AccuracyModifier: player.GetAccuracyModifier(),
+			IsStunned:        player.IsStunned(),
+		},
+	}
+}
+
+// Sent by the server when a status effect ends or gets cured
+func NewStatusEffectExpired(targetId uint64, player *objects.Player, effectName string) Payload {
+	return &Packet_StatusEffectExpired{
+		StatusEffectExpired: &StatusEffectExpired{
+			TargetId:         targetId,
+			EffectName:       effectName,
+			SpeedModifier:    player.GetSpeedModifier(),
+			AccuracyModifier: player.GetAccuracyModifier(),
+			IsStunned:        player.IsStunned(),
+		},
+	}
+}
+
+// Sent by the server with every hazard zone of the region we joined
+func NewHazardZones(zones []*objects.HazardZone) Payload {
+	var pbZones []*HazardZone
+	for _, zone := range zones {
+		var cells []*Position
+		for _, cell := range zone.Cells {
+			cells = append(cells, &Position{X: cell.X, Z: cell.Z})
+		}
+		pbZones = append(pbZones, &HazardZone{
+			Name:       zone.Name,
+			HazardType: zone.Type,
+			Cells:      cells,
+		})
+	}
+
+	return &Packet_HazardZones{
+		HazardZones: &HazardZones{
+			Zones: pbZones,
+		},
+	}
+}
+
+// Sent by the server to spawn an NPC
+func NewSpawnNpc(npc *objects.NPC) Payload {
+	position := npc.GetGridPosition()
+	stats := npc.GetStats()
+
+	return &Packet_SpawnNpc{
+		SpawnNpc: &SpawnNpc{
+			Id:       npc.Id,
+			Template: npc.Template,
+			Name:     npc.Name,
+			Position: &Position{
+				X: position.X,
+				Z: position.Z,
+			},
+			RotationY:   npc.GetRotation(),
+			Health:      npc.GetHealth(),
+			MaxHealth:   npc.GetMaxHealth(),
+			WeaponName:  stats.WeaponName,
+			IsCrouching: npc.IsCrouching(),
+		},
+	}
+}
+
+// Sent by the server to update an NPC's grid position, rotation and stance
+func NewMoveNpc(npc *objects.NPC) Payload {
+	position := npc.GetGridPosition()
+
+	return &Packet_MoveNpc{
+		MoveNpc: &MoveNpc{
+			Id: npc.Id,
+			Position: &Position{
+				X: position.X,
+				Z: position.Z,
+			},
+			RotationY:   npc.GetRotation(),
+			IsCrouching: npc.IsCrouching(),
+		},
+	}
+}
+
+// Sent by the server when an NPC attacks a player
+func NewNpcFire(id, targetId uint64, hit bool) Payload {
+	return &Packet_NpcFire{
+		NpcFire: &NpcFire{
+			Id:       id,
+			TargetId: targetId,
+			Hit:      hit,
+		},
+	}
+}
+
+// Sent by the server when an NPC is killed
+func NewNpcDied(id, attackerId uint64) Payload {
+	return &Packet_NpcDied{
+		NpcDied: &NpcDied{
+			Id:         id,
+			AttackerId: attackerId,
+		},
+	}
+}
+
+// Sent by the server when an NPC is removed without dying
+func NewDespawnNpc(id uint64) Payload {
+	return &Packet_DespawnNpc{
+		DespawnNpc: &DespawnNpc{
+			Id: id,
+		},
+	}
+}
+
+// Sent by the server when a match changes phase, the team is the one of the receiver
+func NewMatchState(match *objects.Match, team uint64, now time.Time) Payload {
+	return &Packet_MatchState{
+		MatchState: &MatchState{
+			Mode:         match.Config.Mode,
+			Phase:        match.Phase,
+			SecondsLeft:  uint64(match.GetTimeLeft(now).Seconds()),
+			ScoreLimit:   match.Config.ScoreLimit,
+			Team:         team,
+			FriendlyFire: match.Config.FriendlyFire,
+		},
+	}
+}
+
+// Sent by the server every time the scores of a match change
+func NewScoreboard(match *objects.Match, now time.Time) Payload {
+	var players []*MatchPlayerScore
+	for _, score := range match.GetScores() {
+		players = append(players, &MatchPlayerScore{
+			Id:     score.ClientId,
+			Name:   score.Name,
+			Team:   score.Team,
+			Kills:  score.Kills,
+			Deaths: score.Deaths,
+		})
+	}
+
+	scoreboard := &Scoreboard{
+		Phase:       match.Phase,
+		Players:     players,
+		SecondsLeft: uint64(match.GetTimeLeft(now).Seconds()),
+	}
+	if match.IsTeamMode() {
+		scoreboard.TeamScores = match.GetTeamScores()
+	}
+
+	// The winner is only known once the match ended
+	if match.Phase == objects.MATCH_PHASE_ENDED {
+		if match.IsTeamMode() {
+			scoreboard.WinnerTeam = match.GetWinningTeam()
+		} else if winner := match.GetWinningPlayer(); winner != nil {
+			scoreboard.WinnerId = winner.ClientId
+		}
+	}
+
+	return &Packet_Scoreboard{
+		Scoreboard: scoreboard,
+	}
+}
+
+// Sent by the server to the whole region when a player or NPC dies
+func NewKillFeed(attackerId uint64, attackerName string, victimId uint64, victimName string, weapon string, isCritical bool) Payload {
+	return &Packet_KillFeed{
+		KillFeed: &KillFeed{
+			AttackerId:   attackerId,
+			AttackerName: attackerName,
+			VictimId:     victimId,
+			VictimName:   victimName,
+			Weapon:       weapon,
+			IsCritical:   isCritical,
+		},
+	}
+}
+
+// Sent by the server with the lifetime combat record of a character
+func NewStatsResponse(nickname string, stats *objects.CombatStats) Payload {
+	var weapons []*WeaponStats
+	for weaponName, usage := range stats.Weapons {
+		weapons = append(weapons, &WeaponStats{
+			WeaponName:  weaponName,
+			Kills:       usage.Kills,
+			DamageDealt: usage.DamageDealt,
+			ShotsFired:  usage.ShotsFired,
+			Hits:        usage.Hits,
+			Headshots:   usage.Headshots,
+		})
+	}
+	// Most used weapons first
+	sort.Slice(weapons, func(i, j int) bool {
+		if weapons[i].Kills != weapons[j].Kills {
+			return weapons[i].Kills > weapons[j].Kills
+		}
+		return weapons[i].WeaponName < weapons[j].WeaponName
+	})
+
+	return &Packet_StatsResponse{
+		StatsResponse: &StatsResponse{
+			Nickname:    nickname,
+			Kills:       stats.Kills,
+			NpcKills:    stats.NpcKills,
+			Deaths:      stats.Deaths,
+			DamageDealt: stats.DamageDealt,
+			DamageTaken: stats.DamageTaken,
+			ShotsFired:  stats.ShotsFired,
+			Hits:        stats.Hits,
+			Headshots:   stats.Headshots,
+			Weapons:     weapons,
+		},
+	}
+}
+
+// Sent by the server with a page of a leaderboard
+func NewLeaderboardResponse(leaderboard *objects.Leaderboard, page uint64, pageSize uint64) Payload {
+	var entries []*LeaderboardEntry
+	for _, entry := range leaderboard.GetPage(page, pageSize) {
+		entries = append(entries, &LeaderboardEntry{
+			Rank:     entry.Rank,
+			Nickname: entry.Nickname,
+			Value:    entry.Value,
+		})
+	}
+
+	return &Packet_LeaderboardResponse{
+		LeaderboardResponse: &LeaderboardResponse{
+			Category:  leaderboard.Category,
+			Page:      max(page, 1),
+			PageCount: leaderboard.GetPageCount(pageSize),
+			Entries:   entries,
+			UpdatedAt: leaderboard.UpdatedAt.Unix(),
+		},
+	}
+}
+
+// Respawner offered to a dead player, with how safe it is right now
+func NewRespawnPoint(position *pathfinding.Cell, rotation float64, safety uint64) *RespawnPoint {
+	return &RespawnPoint{
+		Position: &Position{
+			X: position.X,
+			Z: position.Z,
+		},
+		RotationY: rotation,
+		Safety:    safety,
+	}
+}
+
+// Sent by the server to a dead player so they can choose where to respawn
+func NewRespawnOptions(timeLeft time.Duration, automatic bool, respawners []*RespawnPoint) Payload {
+	return &Packet_RespawnOptions{
+		RespawnOptions: &RespawnOptions{
+			SecondsLeft: uint64(math.Ceil(timeLeft.Seconds())),
+			Automatic:   automatic,
+			Respawners:  respawners,
+		},
+	}
+}
+
+// Sent by the server when a character gains spawn protection (duration > 0) or loses it
+func NewSpawnProtection(id uint64, duration time.Duration) Payload {
+	return &Packet_SpawnProtection{
+		SpawnProtection: &SpawnProtection{
+			Id:           id,
+			Active:       duration > 0,
+			Milliseconds: uint64(duration.Milliseconds()),
+		},
+	}
+}
+
+// Sent to every player when an admin makes an announcement
+func NewAnnouncement(text string) Payload {
+	return &Packet_Announcement{
+		Announcement: &Announcement{
+			Text: text,
+		},
+	}
+}
+
+// Sent right before an admin closes the connection, so the client can show why
+func NewKicked(reason string) Payload {
+	return &Packet_Kicked{
+		Kicked: &Kicked{
+			Reason: reason,
+		},
+	}
+}